@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"golang.org/x/crypto/bcrypt"
 	"gopkg.in/alecthomas/kingpin.v2"
 )
 
@@ -21,6 +22,7 @@ var (
 	ebCMD       = kingpin.New("eonbot", "")
 	botCMD      = ebCMD.Command("bot", "Assets analysis and trading bot.").Default()
 	exchangeCMD = ebCMD.Command("exchange", "Exchange driver testing tool.")
+	hashCMD     = ebCMD.Command("hash-password", "Internal remote controller user's password hashing tool.")
 )
 
 func init() {
@@ -35,6 +37,8 @@ func main() {
 		botCommand()
 	case exchangeCMD.FullCommand():
 		exchangeCommand()
+	case hashCMD.FullCommand():
+		hashCommand()
 	}
 }
 
@@ -79,6 +83,9 @@ var (
 	botRCPort = botCMD.Flag("port", "Port to use for internal remote controller.").
 			Short('p').Default("8080").Int()
 
+	botMetricsPort = botCMD.Flag("metrics-port", "Port to use for metrics endpoint (/metrics). Metrics are disabled when not specified.").
+			Default("0").Int()
+
 	botHTTPTimeout = botCMD.Flag("http-timeout", "Specify the max amount of time (in seconds) the request should take to go to the exchange driver and receive response.").
 			Default("30").Int64()
 )
@@ -118,6 +125,7 @@ func botCommand() {
 		ReloadStop:    *botReloadStop,
 		AutoStart:     *botAutoStart,
 		RCPort:        *botRCPort,
+		MetricsPort:   *botMetricsPort,
 		HTTPTimeout:   *botHTTPTimeout,
 	}
 
@@ -562,3 +570,20 @@ func exchangeCommand() {
 
 	fmt.Println(b.String())
 }
+
+var (
+	/*
+		hash-password commands
+	*/
+
+	hashPassword = hashCMD.Arg("password", "Password to hash.").Required().String()
+)
+
+func hashCommand() {
+	hash, err := bcrypt.GenerateFromPassword([]byte(*hashPassword), bcrypt.DefaultCost)
+	if err != nil {
+		ebCMD.Fatalf("%s", err)
+	}
+
+	fmt.Println(string(hash))
+}
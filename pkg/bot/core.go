@@ -4,8 +4,10 @@ import (
 	"eonbot/pkg/config"
 	"eonbot/pkg/control"
 	"eonbot/pkg/db"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/file"
+	"eonbot/pkg/metrics"
 	"eonbot/pkg/remote"
 	"eonbot/pkg/settings"
 	"eonbot/pkg/stream"
@@ -106,10 +108,18 @@ type botProcess struct {
 	// DB specifies persistent filesystem / in-memory bot store manager.
 	DB db.Manager
 
+	// Events specifies event bus used to notify
+	// remote controllers, the audit log, etc.
+	Events *event.Bus
+
 	// RC specifies manager used to communicate with
 	// external processes and services.
 	RC remote.Manager
 
+	// Metrics specifies metrics endpoint server. It is
+	// nil when metrics are disabled.
+	Metrics *metrics.Server
+
 	// streams specifies asset pairs streams used
 	// to execute strategies / place orders and retain
 	// pair specific data between multiple cycle in an
@@ -156,8 +166,18 @@ func newBotProcess(conf settings.Exec) (*botProcess, error) {
 
 	proc.DB = dbMan
 
+	// create new event bus and save
+	// audit log events to the db.
+	proc.Events = event.NewBus()
+	proc.Events.Subscribe(proc.saveEvent, auditEvents)
+
 	// create new remote control manager.
-	proc.RC = remote.New(proc.Conf, proc.Control, proc.DB, proc.Exchange)
+	proc.RC = remote.New(proc.Conf, proc.Control, proc.DB, proc.Exchange, proc.Events)
+
+	// start metrics server, if enabled.
+	if port := proc.Conf.ExecConfig().Get().MetricsPort; port != 0 {
+		proc.Metrics = metrics.NewServer(port)
+	}
 
 	// init streams map.
 	proc.streams = make(map[string]*stream.Stream)
@@ -263,6 +283,8 @@ ProcessLoop:
 			// apply changes.
 			if mod {
 				logrus.StandardLogger().Debug("configs modified, restarting process loop")
+				b.Events.Publish(event.ConfigReload, nil)
+
 				// drain timer channel, so that
 				// only stop channel event
 				// is received in select case below.
@@ -342,6 +364,12 @@ func (b *botProcess) handleConfigs() error {
 		// configure telegram, if needed.
 		b.RC.ConfigTelegram()
 
+		// replace webhooks with the new ones.
+		b.RC.ConfigWebhooks()
+
+		// replace email notifier with the new one.
+		b.RC.ConfigEmail()
+
 		// if exchange driver address is different than used by the exchange driver client,
 		// update it.
 		if b.Conf.RemoteConfig().Get().ExchangeDriverAddress != b.Exchange.GetAddress() {
@@ -419,5 +447,8 @@ func (b *botProcess) handleTermination() {
 	<-c
 	b.DB.CloseAll()
 	b.RC.Stop()
+	if b.Metrics != nil {
+		b.Metrics.Stop()
+	}
 	os.Exit(0)
 }
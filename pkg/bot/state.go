@@ -2,7 +2,23 @@ package bot
 
 import (
 	"eonbot/pkg/control"
-	"eonbot/pkg/remote/inner"
+	"eonbot/pkg/event"
+
+	"github.com/sirupsen/logrus"
+)
+
+// auditEvents specifies which events should
+// be saved to the db audit log.
+var auditEvents = event.Types(
+	event.StateChange,
+	event.ConfigReload,
+	event.CooldownActivation,
+	event.PairCycleFailed,
+	event.StrategyFired,
+	event.OrderPlaced,
+	event.OrderFilled,
+	event.OrderCancelled,
+	event.RemoteAction,
 )
 
 // onStateChange is used as a callback when the state
@@ -13,7 +29,13 @@ func (b *botProcess) onStateChange(s control.StateInfoer) {
 		return
 	}
 
-	// send notifications to remote controllers.
-	b.RC.TelegramSend(s.StringShort())
-	b.RC.InternalSend(inner.StateChangeEvent)
+	// notify remote controllers, etc.
+	b.Events.Publish(event.StateChange, event.StatePayload{State: s.StringShort(), Info: s})
+}
+
+// saveEvent saves event to the db audit log.
+func (b *botProcess) saveEvent(e event.Event) {
+	if err := b.DB.Persistent().SaveEvent(e); err != nil {
+		logrus.StandardLogger().WithField("action", "event saving to db").Error(err)
+	}
 }
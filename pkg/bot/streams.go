@@ -4,7 +4,8 @@ import (
 	"eonbot/pkg"
 	"eonbot/pkg/asset"
 	"eonbot/pkg/config"
-	"eonbot/pkg/remote/inner"
+	"eonbot/pkg/event"
+	"eonbot/pkg/metrics"
 	"eonbot/pkg/strategy"
 	"eonbot/pkg/stream"
 	"fmt"
@@ -80,7 +81,7 @@ func (b *botProcess) reconfigureStreams() error {
 						stream.StreamConfig{
 							Config: sub,
 							IsMain: false,
-						}, b.RC, b.DB, b.Exchange, strats)
+						}, b.Events, b.DB, b.Exchange, strats)
 					if err != nil {
 						return err
 					}
@@ -105,7 +106,7 @@ func (b *botProcess) reconfigureStreams() error {
 							stream.StreamConfig{
 								Config: main.PairsConfig,
 								IsMain: true,
-							}, b.RC, b.DB, b.Exchange, strats)
+							}, b.Events, b.DB, b.Exchange, strats)
 						if err != nil {
 							return err
 						}
@@ -142,7 +143,7 @@ func (b *botProcess) reconfigureStreams() error {
 					stream.StreamConfig{
 						Config: conf,
 						IsMain: isMain,
-					}, b.RC, b.DB, b.Exchange, strats)
+					}, b.Events, b.DB, b.Exchange, strats)
 				if err != nil {
 					return err
 				}
@@ -188,7 +189,9 @@ func (b *botProcess) reconfigureStreams() error {
 					if check {
 						switch b.Conf.Strategies().IsStratChanged(k) {
 						case config.Changed: // if strategy was changed, update it.
-							strm.UpdateStrategy(v, b.Conf.Strategies().Get(k))
+							if err := strm.UpdateStrategy(v, b.Conf.Strategies().Get(k)); err != nil {
+								return err
+							}
 						case config.NotExists: // if strategy does not exist, but is being used by the stream, return error.
 							return fmt.Errorf("'%s' strategy, which is being used by %s pair, does not exist", k, strm.Pair.String())
 						}
@@ -234,6 +237,12 @@ func (b *botProcess) streamsCleanUp() {
 				delete(b.streams, k)
 			}
 		}
+
+		// remove saved strategies states of
+		// pairs that are not active anymore.
+		if err := b.DB.Persistent().PrunePairsStrategyStates(b.Conf.MainConfig().Get().BotConfig.ActivePairs); err != nil {
+			logrus.WithField("action", "inactive pairs strategy states pruning").Error(err)
+		}
 	}
 }
 
@@ -304,7 +313,8 @@ func (b *botProcess) execNormal() {
 
 		if cooldown.Active {
 			// notify RC about cooldown activation.
-			b.RC.InternalSend(inner.CooldownActivationEvent)
+			b.Events.Publish(event.CooldownActivation, nil)
+			metrics.CooldownActivations.Inc()
 		}
 	}()
 
@@ -325,6 +335,12 @@ func (b *botProcess) execNormal() {
 		return
 	}
 
+	// update balances metrics.
+	for asset, bal := range balances {
+		val, _ := bal.Float64()
+		metrics.Balances.Set(val, asset)
+	}
+
 	// loop over streams map and start
 	// them in normal mode.
 	for _, s := range b.streams {
@@ -352,8 +368,19 @@ func (b *botProcess) execNormal() {
 		// start stream's normal mode execution in
 		// a separate goroutine.
 		go func(started time.Time, strm *stream.Stream) {
-			// exec stream in normal mode.
-			res, err := strm.Normal(bal)
+			var res pkg.Resulter
+			var err error
+			switch {
+			case b.Control.TakeForceSell(strm.Pair):
+				// exec stream in sell mode, if it was
+				// requested by remote controller.
+				res, err = b.forceSell(strm, bal)
+			case b.Control.IsPairPaused(strm.Pair):
+				res = pkg.NewSuspendedResult("paused by remote control")
+			default:
+				// exec stream in normal mode.
+				res, err = strm.Normal(bal)
+			}
 
 			if err != nil {
 				logrus.StandardLogger().Error(err)
@@ -361,6 +388,14 @@ func (b *botProcess) execNormal() {
 
 			// group cycle result data.
 			cyc := pkg.NewStreamCycle(started, time.Now().UTC(), res, err)
+			metrics.CycleDuration.Observe(cyc.CompletedAt.Sub(cyc.StartedAt).Seconds(), strm.Pair.String())
+
+			// notify about cycle completion.
+			cycEvent := event.PairCycleCompleted
+			if err != nil {
+				cycEvent = event.PairCycleFailed
+			}
+			b.Events.Publish(cycEvent, event.CyclePayload{Pair: strm.Pair.String(), Cycle: cyc})
 
 			// save pair's cycle info to db.
 			if err := b.DB.Persistent().SavePairCycle(strm.Pair, cyc); err != nil {
@@ -376,11 +411,31 @@ func (b *botProcess) execNormal() {
 	wg.Wait()
 
 	// notify RC about cycle end.
-	b.RC.InternalSend(inner.PairCycleEndEvent)
+	b.Events.Publish(event.CycleEnd, nil)
 
 	logrus.StandardLogger().Debug("completed cycle execution")
 }
 
+// forceSell executes stream in sell mode. If sell order cannot
+// be placed, force sell request is queued again, so that it
+// would be retried during the next cycle.
+func (b *botProcess) forceSell(strm *stream.Stream, bal stream.BalancesPair) (pkg.Resulter, error) {
+	reason := "force sell requested by remote control"
+	if b.Control.IsPairPaused(strm.Pair) {
+		reason += " (pair is paused, force sell is executed anyway)"
+	}
+
+	switch err := strm.ForceSell(bal); err {
+	case nil:
+		return pkg.NewSuspendedResult(reason), nil
+	case stream.ErrNothingToSell:
+		return pkg.NewSuspendedResult(fmt.Sprintf("%s, but it was not executed: %s", reason, err.Error())), nil
+	default:
+		b.Control.ForceSellPair(strm.Pair)
+		return pkg.NewSuspendedResult(reason + ", but it failed and will be retried during the next cycle"), err
+	}
+}
+
 /*
    side tasks execution
 */
@@ -13,6 +13,9 @@ type Controller interface {
 	Starter
 	Stopper
 
+	// pairs control
+	PairController
+
 	Restart(start StartInfo, stop StopInfo, cause cause)
 }
 
@@ -35,6 +38,12 @@ type Control struct {
 
 		pen pendingAction
 	}
+
+	pairs struct {
+		mu        sync.RWMutex
+		paused    map[string]struct{}
+		forceSell map[string]struct{}
+	}
 }
 
 func New(cb func(s StateInfoer)) *Control {
@@ -43,6 +52,8 @@ func New(cb func(s StateInfoer)) *Control {
 	ctrl.state.cb = cb
 	ctrl.start.ch = make(chan StartInfo)
 	ctrl.stop.ch = make(chan StopInfo)
+	ctrl.pairs.paused = make(map[string]struct{})
+	ctrl.pairs.forceSell = make(map[string]struct{})
 	return ctrl
 }
 
@@ -0,0 +1,91 @@
+package control
+
+import (
+	"eonbot/pkg/asset"
+	"sort"
+)
+
+// PairController controls execution of
+// specific pairs' streams.
+type PairController interface {
+	// PausePair prevents pair's stream from being executed
+	// until it is resumed. Returns false if the pair is
+	// already paused.
+	PausePair(pair asset.Pair) bool
+
+	// ResumePair allows paused pair's stream to be executed
+	// again. Returns false if the pair is not paused.
+	ResumePair(pair asset.Pair) bool
+
+	// IsPairPaused checks whether the pair is paused.
+	IsPairPaused(pair asset.Pair) bool
+
+	// PausedPairs returns sorted codes of paused pairs.
+	PausedPairs() []string
+
+	// ForceSellPair requests pair's base asset to be sold
+	// during the next cycle instead of the normal execution.
+	ForceSellPair(pair asset.Pair)
+
+	// TakeForceSell checks whether pair's force sell was
+	// requested and clears the request.
+	TakeForceSell(pair asset.Pair) bool
+}
+
+func (c *Control) PausePair(pair asset.Pair) bool {
+	c.pairs.mu.Lock()
+	defer c.pairs.mu.Unlock()
+	if _, ok := c.pairs.paused[pair.String()]; ok {
+		return false
+	}
+
+	c.pairs.paused[pair.String()] = struct{}{}
+	return true
+}
+
+func (c *Control) ResumePair(pair asset.Pair) bool {
+	c.pairs.mu.Lock()
+	defer c.pairs.mu.Unlock()
+	if _, ok := c.pairs.paused[pair.String()]; !ok {
+		return false
+	}
+
+	delete(c.pairs.paused, pair.String())
+	return true
+}
+
+func (c *Control) IsPairPaused(pair asset.Pair) bool {
+	c.pairs.mu.RLock()
+	_, ok := c.pairs.paused[pair.String()]
+	c.pairs.mu.RUnlock()
+	return ok
+}
+
+func (c *Control) PausedPairs() []string {
+	c.pairs.mu.RLock()
+	pairs := make([]string, 0, len(c.pairs.paused))
+	for pair := range c.pairs.paused {
+		pairs = append(pairs, pair)
+	}
+	c.pairs.mu.RUnlock()
+
+	sort.Strings(pairs)
+	return pairs
+}
+
+func (c *Control) ForceSellPair(pair asset.Pair) {
+	c.pairs.mu.Lock()
+	c.pairs.forceSell[pair.String()] = struct{}{}
+	c.pairs.mu.Unlock()
+}
+
+func (c *Control) TakeForceSell(pair asset.Pair) bool {
+	c.pairs.mu.Lock()
+	defer c.pairs.mu.Unlock()
+	if _, ok := c.pairs.forceSell[pair.String()]; !ok {
+		return false
+	}
+
+	delete(c.pairs.forceSell, pair.String())
+	return true
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"eonbot/pkg"
 	"eonbot/pkg/asset"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/file"
 	"errors"
@@ -18,13 +19,17 @@ import (
 
 const (
 	dbFile         = "data.db"
-	maxSavedCycles = 10 // max amount of cycles of specific pair to save
+	maxSavedCycles = 10   // max amount of cycles of specific pair to save
+	maxSavedEvents = 1000 // max amount of audit log events to save
 )
 
 var (
 	telegramSubsBucket = []byte("telegram-subs")
 	cyclesBucket       = []byte("cycles")
 	ordersBucket       = []byte("orders")
+	stratStatesBucket  = []byte("strategies-states")
+	eventsBucket       = []byte("events")
+	apiTokensBucket    = []byte("api-tokens")
 )
 
 var (
@@ -33,6 +38,43 @@ var (
 	ErrDataNotFound = errors.New("data not found")
 )
 
+// TelegramSubscriber contains telegram chat's
+// notifications preferences.
+type TelegramSubscriber struct {
+	// ChatID specifies subscribed chat's id.
+	ChatID int64 `json:"-"`
+
+	// Username specifies telegram user who
+	// subscribed the chat. Empty for
+	// subscriptions saved by older versions.
+	Username string `json:"username"`
+
+	// Events specifies events the chat is
+	// notified about. If nil, default events
+	// are used.
+	Events []event.Type `json:"events"`
+}
+
+// APIToken contains internal RC
+// API token's data.
+type APIToken struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// Scope specifies what the token
+	// is allowed to do.
+	Scope string `json:"scope"`
+
+	// Hash specifies SHA-256 hex encoded hash of
+	// the token, tokens themselves are not saved.
+	Hash string `json:"hash"`
+
+	// CreatedBy specifies RC user who
+	// created the token.
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // PersistentStorer defines methods
 // used to store and retrieve
 // specific data from persistent
@@ -41,14 +83,14 @@ type PersistentStorer interface {
 	// close closes open database's connection.
 	close()
 
-	// SaveTelegramSubscriber saves provided chat
-	// id to the db, so that when bot is restarted
+	// SaveTelegramSubscriber saves provided chat's
+	// subscription to the db, so that when bot is restarted
 	// chat's wouldn't need to be re-subscribed.
-	SaveTelegramSubscriber(id int64) error
+	SaveTelegramSubscriber(sub TelegramSubscriber) error
 
 	// GetTelegramSubscribers retrieves currently
-	// saved telegram chat ids from the db.
-	GetTelegramSubscribers() ([]int64, error)
+	// saved telegram chats' subscriptions from the db.
+	GetTelegramSubscribers() ([]TelegramSubscriber, error)
 
 	// DeleteTelegramSubscriber removes specific id
 	// from the db.
@@ -94,6 +136,42 @@ type PersistentStorer interface {
 	// GetOrdersCount retrieves all pairs total orders
 	// count from the db.
 	GetOrdersCount() (int, error)
+
+	// SaveStrategyStates saves specific pair's strategies
+	// serialized states to the db in a single transaction.
+	// The key of the states map is strategy's name.
+	SaveStrategyStates(pair asset.Pair, states map[string]json.RawMessage) error
+
+	// PruneStrategyStates removes specific pair's strategies
+	// states, except the ones of provided strategies.
+	PruneStrategyStates(pair asset.Pair, keep []string) error
+
+	// PrunePairsStrategyStates removes strategies states of
+	// all pairs, except the provided ones.
+	PrunePairsStrategyStates(keep []asset.Pair) error
+
+	// GetStrategyState retrieves specific pair's strategy
+	// serialized state from the db.
+	GetStrategyState(pair asset.Pair, strat string) (json.RawMessage, error)
+
+	// SaveEvent saves event to the audit log. Only the latest
+	// events are kept.
+	SaveEvent(e event.Event) error
+
+	// GetEvents retrieves audit log events in the provided time
+	// interval from the db.
+	GetEvents(start, end time.Time) ([]event.Event, error)
+
+	// SaveAPIToken saves internal RC API token to the db.
+	SaveAPIToken(token APIToken) error
+
+	// GetAPITokens retrieves all internal RC API
+	// tokens from the db.
+	GetAPITokens() ([]APIToken, error)
+
+	// DeleteAPIToken removes internal RC API token
+	// by its id from the db.
+	DeleteAPIToken(id string) error
 }
 
 // persistentStore contains persistent
@@ -129,7 +207,13 @@ func (p *persistentStore) close() {
    Telegram subscribers
 */
 
-func (p *persistentStore) SaveTelegramSubscriber(id int64) error {
+func (p *persistentStore) SaveTelegramSubscriber(sub TelegramSubscriber) error {
+	// convert to json.
+	data, err := json.Marshal(sub)
+	if err != nil {
+		return err
+	}
+
 	return p.db.Update(func(tx *bolt.Tx) error {
 		// find or create telegram subscribers bucket.
 		b, err := tx.CreateBucketIfNotExists(telegramSubsBucket)
@@ -138,12 +222,12 @@ func (p *persistentStore) SaveTelegramSubscriber(id int64) error {
 		}
 
 		// save or update data.
-		return b.Put([]byte(strconv.FormatInt(id, 10)), []byte{})
+		return b.Put([]byte(strconv.FormatInt(sub.ChatID, 10)), data)
 	})
 }
 
-func (p *persistentStore) GetTelegramSubscribers() ([]int64, error) {
-	subs := make([]int64, 0)
+func (p *persistentStore) GetTelegramSubscribers() ([]TelegramSubscriber, error) {
+	subs := make([]TelegramSubscriber, 0)
 	err := p.db.View(func(tx *bolt.Tx) error {
 		// retrieve subscribers bucket.
 		b := tx.Bucket(telegramSubsBucket)
@@ -160,7 +244,16 @@ func (p *persistentStore) GetTelegramSubscribers() ([]int64, error) {
 				return err
 			}
 
-			subs = append(subs, id)
+			sub := TelegramSubscriber{ChatID: id}
+
+			// older versions saved only chat ids.
+			if len(v) > 0 {
+				if err := json.Unmarshal(v, &sub); err != nil {
+					return err
+				}
+			}
+
+			subs = append(subs, sub)
 			return nil
 		})
 	})
@@ -473,7 +566,7 @@ func (p *persistentStore) GetOrders(start, end time.Time) (map[string][]exchange
 							if err := json.Unmarshal(tv, &order); err != nil {
 								return err
 							}
-							orders[string(k)] = append(orders[string(pk)], order)
+							orders[string(k)] = append(orders[string(k)], order)
 							return nil
 						})
 
@@ -703,6 +796,307 @@ func (p *persistentStore) GetOrdersCount() (int, error) {
 	return res, nil
 }
 
+/*
+   Pair strategies states
+*/
+
+func (p *persistentStore) SaveStrategyStates(pair asset.Pair, states map[string]json.RawMessage) error {
+	// pair must be valid to continue.
+	if err := pair.RequireValid(); err != nil {
+		return err
+	}
+
+	if len(states) == 0 {
+		return nil
+	}
+
+	return p.db.Update(func(tx *bolt.Tx) error {
+		// find or create strategies states bucket.
+		b, err := tx.CreateBucketIfNotExists(stratStatesBucket)
+		if err != nil {
+			return err
+		}
+
+		// find or create pair bucket.
+		pb, err := b.CreateBucketIfNotExists([]byte(pair.String()))
+		if err != nil {
+			return err
+		}
+
+		for strat, state := range states {
+			// save or update data.
+			if err := pb.Put([]byte(strat), state); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *persistentStore) PruneStrategyStates(pair asset.Pair, keep []string) error {
+	// pair must be valid to continue.
+	if err := pair.RequireValid(); err != nil {
+		return err
+	}
+
+	kept := make(map[string]bool, len(keep))
+	for _, strat := range keep {
+		kept[strat] = true
+	}
+
+	return p.db.Update(func(tx *bolt.Tx) error {
+		// find strategies states bucket.
+		b := tx.Bucket(stratStatesBucket)
+		if b == nil {
+			return nil
+		}
+
+		// find pair bucket.
+		pb := b.Bucket([]byte(pair.String()))
+		if pb == nil {
+			return nil
+		}
+
+		// collect keys first, because bucket must
+		// not be modified during iteration.
+		var removed [][]byte
+		err := pb.ForEach(func(k, _ []byte) error {
+			if !kept[string(k)] {
+				removed = append(removed, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range removed {
+			if err := pb.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *persistentStore) PrunePairsStrategyStates(keep []asset.Pair) error {
+	kept := make(map[string]bool, len(keep))
+	for _, pair := range keep {
+		kept[pair.String()] = true
+	}
+
+	return p.db.Update(func(tx *bolt.Tx) error {
+		// find strategies states bucket.
+		b := tx.Bucket(stratStatesBucket)
+		if b == nil {
+			return nil
+		}
+
+		// collect pairs' keys first, because bucket
+		// must not be modified during iteration.
+		var removed [][]byte
+		err := b.ForEach(func(k, _ []byte) error {
+			if !kept[string(k)] {
+				removed = append(removed, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range removed {
+			if err := b.DeleteBucket(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *persistentStore) GetStrategyState(pair asset.Pair, strat string) (json.RawMessage, error) {
+	// pair must be valid to continue.
+	if err := pair.RequireValid(); err != nil {
+		return nil, err
+	}
+
+	var state json.RawMessage
+	err := p.db.View(func(tx *bolt.Tx) error {
+		// find strategies states bucket.
+		b := tx.Bucket(stratStatesBucket)
+		if b == nil {
+			return ErrDataNotFound
+		}
+
+		// find pair bucket.
+		pb := b.Bucket([]byte(pair.String()))
+		if pb == nil {
+			return ErrDataNotFound
+		}
+
+		// retrieve state by strategy name.
+		bState := pb.Get([]byte(strat))
+		if bState == nil {
+			return ErrDataNotFound
+		}
+
+		// bolt's data is valid only during
+		// the transaction, so it must be copied.
+		state = append(json.RawMessage(nil), bState...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+/*
+   Audit log events
+*/
+
+func (p *persistentStore) SaveEvent(e event.Event) error {
+	return p.db.Update(func(tx *bolt.Tx) error {
+		// find or create events bucket.
+		b, err := tx.CreateBucketIfNotExists(eventsBucket)
+		if err != nil {
+			return err
+		}
+
+		// convert event data to json.
+		bEvent, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		// generate event db id.
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		if err := b.Put(itob(id), bEvent); err != nil {
+			return err
+		}
+
+		// clean up old events (if more than allowed exist).
+		if count := b.Stats().KeyN - maxSavedEvents; count > 0 {
+			c := b.Cursor()
+			for k, _ := c.First(); k != nil && count > 0; k, _ = c.Next() {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+				count--
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p *persistentStore) GetEvents(start, end time.Time) ([]event.Event, error) {
+	events := make([]event.Event, 0)
+	err := p.db.View(func(tx *bolt.Tx) error {
+		// find events bucket.
+		b := tx.Bucket(eventsBucket)
+		if b == nil {
+			return ErrDataNotFound
+		}
+
+		// events are saved in chronological order.
+		return b.ForEach(func(k []byte, v []byte) error {
+			var e event.Event
+
+			// convert from json.
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+
+			if e.Timestamp.Before(start) || (!end.IsZero() && e.Timestamp.After(end)) {
+				return nil
+			}
+
+			events = append(events, e)
+			return nil
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+/*
+   Internal RC API tokens
+*/
+
+func (p *persistentStore) SaveAPIToken(token APIToken) error {
+	// convert to json.
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return p.db.Update(func(tx *bolt.Tx) error {
+		// find or create tokens bucket.
+		b, err := tx.CreateBucketIfNotExists(apiTokensBucket)
+		if err != nil {
+			return err
+		}
+
+		// save or update data.
+		return b.Put([]byte(token.ID), data)
+	})
+}
+
+func (p *persistentStore) GetAPITokens() ([]APIToken, error) {
+	tokens := make([]APIToken, 0)
+	err := p.db.View(func(tx *bolt.Tx) error {
+		// retrieve tokens bucket.
+		b := tx.Bucket(apiTokensBucket)
+		if b == nil {
+			return nil // no need to error if tokens don't exist
+		}
+
+		return b.ForEach(func(k []byte, v []byte) error {
+			var token APIToken
+
+			// convert from json.
+			if err := json.Unmarshal(v, &token); err != nil {
+				return err
+			}
+
+			tokens = append(tokens, token)
+			return nil
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (p *persistentStore) DeleteAPIToken(id string) error {
+	return p.db.Update(func(tx *bolt.Tx) error {
+		// retrieve tokens bucket.
+		b := tx.Bucket(apiTokensBucket)
+		if b == nil || b.Get([]byte(id)) == nil {
+			return ErrDataNotFound
+		}
+
+		// remove data.
+		return b.Delete([]byte(id))
+	})
+}
+
 // itob returns an 8-byte big endian representation of v.
 // From: https://github.com/boltdb/bolt#autoincrementing-integer-for-the-bucket
 func itob(v uint64) []byte {
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"eonbot/pkg/asset"
+	"eonbot/pkg/exchange"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore(t *testing.T) (*persistentStore, func()) {
+	dir, err := ioutil.TempDir("", "eonbot-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := bolt.Open(path.Join(dir, dbFile), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return &persistentStore{db: db}, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestPersistentGetOrders(t *testing.T) {
+	store, cleanUp := newTestStore(t)
+	defer cleanUp()
+
+	ethBTC := asset.NewPair("ETH", "BTC")
+	dgbBTC := asset.NewPair("DGB", "BTC")
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	order := func(id string, ts time.Time) exchange.Order {
+		return exchange.Order{ID: id, Timestamp: ts, Amount: decimal.New(1, 0), Rate: decimal.New(1, 0)}
+	}
+
+	// multiple timestamps per pair, so that orders of each
+	// timestamp bucket would be collected under pair's key.
+	assert.Nil(t, store.SavePairOrder(ethBTC, order("1", start), "buy"))
+	assert.Nil(t, store.SavePairOrder(ethBTC, order("2", start.Add(time.Hour)), "sell"))
+	assert.Nil(t, store.SavePairOrder(dgbBTC, order("3", start.Add(time.Minute)), "buy"))
+	assert.Nil(t, store.SavePairOrder(dgbBTC, order("4", start.Add(2*time.Hour)), "sell"))
+	assert.Nil(t, store.SavePairOrder(dgbBTC, order("5", start.Add(48*time.Hour)), "sell"))
+
+	res, err := store.GetOrders(start, start.Add(24*time.Hour))
+	assert.Nil(t, err)
+	assert.Len(t, res, 2)
+
+	ids := func(oo []exchange.BotOrder) []string {
+		res := make([]string, 0, len(oo))
+		for _, o := range oo {
+			res = append(res, o.ID)
+		}
+		return res
+	}
+
+	assert.Equal(t, []string{"1", "2"}, ids(res[ethBTC.String()]))
+	assert.Equal(t, []string{"3", "4"}, ids(res[dgbBTC.String()]))
+}
@@ -0,0 +1,127 @@
+package event
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// queueSize specifies how many events can be waiting
+// for a single subscriber's handler before new ones are
+// dropped.
+const queueSize = 100
+
+// Handler is called for every event
+// accepted by subscription's filter.
+type Handler func(e Event)
+
+// Filter specifies which events should be
+// passed to subscription's handler.
+type Filter func(e Event) bool
+
+// All accepts all events.
+func All(e Event) bool {
+	return true
+}
+
+// Types accepts only events of
+// the specified types.
+func Types(tt ...Type) Filter {
+	return func(e Event) bool {
+		for _, t := range tt {
+			if e.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Bus passes published events to all
+// subscribers whose filters accept them.
+type Bus struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[int]*subscription
+
+	// now returns current time, it is replaced
+	// in tests.
+	now func() time.Time
+}
+
+type subscription struct {
+	filter Filter
+	queue  chan Event
+}
+
+// NewBus creates new event bus.
+func NewBus() *Bus {
+	return &Bus{
+		subs: make(map[int]*subscription),
+		now:  time.Now,
+	}
+}
+
+// Subscribe registers new subscriber. Handler is called in
+// a separate goroutine, events are passed to it in the same
+// order they were published. Returned function removes
+// the subscription.
+func (b *Bus) Subscribe(h Handler, f Filter) func() {
+	if f == nil {
+		f = All
+	}
+
+	sub := &subscription{
+		filter: f,
+		queue:  make(chan Event, queueSize),
+	}
+
+	go func() {
+		for e := range sub.queue {
+			h(e)
+		}
+	}()
+
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.subs[id] = sub
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, id)
+			close(sub.queue)
+			b.mu.Unlock()
+		})
+	}
+}
+
+// Publish creates new event and passes it to
+// subscribers. It never blocks, if subscriber's
+// queue is full, the event is dropped for it.
+func (b *Bus) Publish(t Type, payload interface{}) {
+	e := Event{
+		Type:      t,
+		Timestamp: b.now().UTC(),
+		Payload:   payload,
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subs {
+		if !sub.filter(e) {
+			continue
+		}
+
+		select {
+		case sub.queue <- e:
+		default:
+			logrus.StandardLogger().WithField("action", "event publishing").Errorf("subscriber's queue is full, %s event dropped", e.Type)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receive(t *testing.T, c chan Event) Event {
+	select {
+	case e := <-c:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("event was not received")
+	}
+	return Event{}
+}
+
+func TestBusPublish(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := NewBus()
+	b.now = func() time.Time { return now }
+
+	all := make(chan Event, 10)
+	b.Subscribe(func(e Event) { all <- e }, nil)
+
+	orders := make(chan Event, 10)
+	b.Subscribe(func(e Event) { orders <- e }, Types(OrderPlaced, OrderFilled))
+
+	b.Publish(StateChange, StatePayload{State: "running"})
+	b.Publish(OrderPlaced, OrderPayload{Pair: "ETH_BTC", ID: "1"})
+	b.Publish(OrderFilled, OrderPayload{Pair: "ETH_BTC", ID: "1"})
+
+	assert.Equal(t, Event{Type: StateChange, Timestamp: now, Payload: StatePayload{State: "running"}}, receive(t, all))
+	assert.Equal(t, OrderPlaced, receive(t, all).Type)
+	assert.Equal(t, OrderFilled, receive(t, all).Type)
+
+	assert.Equal(t, Event{Type: OrderPlaced, Timestamp: now, Payload: OrderPayload{Pair: "ETH_BTC", ID: "1"}}, receive(t, orders))
+	assert.Equal(t, OrderFilled, receive(t, orders).Type)
+
+	select {
+	case e := <-orders:
+		t.Fatalf("unexpected %s event received", e.Type)
+	case <-time.After(time.Millisecond * 50):
+	}
+}
+
+func TestBusUnsubscribe(t *testing.T) {
+	b := NewBus()
+
+	c := make(chan Event, 10)
+	unsub := b.Subscribe(func(e Event) { c <- e }, nil)
+
+	b.Publish(ConfigReload, nil)
+	assert.Equal(t, ConfigReload, receive(t, c).Type)
+
+	unsub()
+	unsub()
+
+	b.Publish(ConfigReload, nil)
+	select {
+	case e := <-c:
+		t.Fatalf("unexpected %s event received", e.Type)
+	case <-time.After(time.Millisecond * 50):
+	}
+}
+
+func TestBusFullQueue(t *testing.T) {
+	b := NewBus()
+
+	block := make(chan struct{})
+	c := make(chan Event, queueSize*2)
+	b.Subscribe(func(e Event) {
+		<-block
+		c <- e
+	}, nil)
+
+	// publishing must not block even if
+	// subscriber does not keep up.
+	for i := 0; i < queueSize*2; i++ {
+		b.Publish(CycleEnd, nil)
+	}
+	close(block)
+
+	receive(t, c)
+	assert.True(t, len(c) <= queueSize+1)
+}
@@ -0,0 +1,179 @@
+// Package event implements bot's internal event bus which is
+// used to notify remote controllers, the db audit log and other
+// sinks about things happening inside the bot.
+package event
+
+import (
+	"eonbot/pkg"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Type specifies event's type.
+type Type string
+
+const (
+	// StateChange is published when bot's state changes.
+	// Payload: StatePayload.
+	StateChange Type = "state-update"
+
+	// CooldownActivation is published when exchange
+	// cooldown is activated. Payload: none.
+	CooldownActivation Type = "cooldown-activation"
+
+	// ConfigReload is published when modified configs
+	// are loaded. Payload: none.
+	ConfigReload Type = "config-reload"
+
+	// CycleEnd is published when all pairs' streams
+	// complete their cycles. Payload: none.
+	CycleEnd Type = "pair-cycle-end"
+
+	// PairCycleCompleted is published when pair's stream
+	// cycle completes successfully. Payload: CyclePayload.
+	PairCycleCompleted Type = "pair-cycle-completed"
+
+	// PairCycleFailed is published when pair's stream
+	// cycle completes with an error. Payload: CyclePayload.
+	PairCycleFailed Type = "pair-cycle-failed"
+
+	// StrategyFired is published when strategy's conditions
+	// are met and its outcomes are activated. Payload: StrategyPayload.
+	StrategyFired Type = "strategy-fired"
+
+	// OrderPlaced is published when the bot places
+	// an order. Payload: OrderPayload.
+	OrderPlaced Type = "order-placed"
+
+	// OrderFilled is published when order placed by
+	// the bot is filled. Payload: OrderPayload.
+	OrderFilled Type = "order-filled"
+
+	// OrderCancelled is published when the bot cancels
+	// an order. Payload: OrderPayload.
+	OrderCancelled Type = "order-cancelled"
+
+	// OutcomeMessage is published when strategy's message
+	// outcome is activated. Payload: MessagePayload.
+	OutcomeMessage Type = "outcome-message"
+
+	// RemoteAction is published when an action is executed
+	// through a remote controller. Payload: ActionPayload.
+	RemoteAction Type = "remote-action"
+)
+
+// IsValid checks whether the type is
+// one of the known event types.
+func (t Type) IsValid() bool {
+	switch t {
+	case StateChange, CooldownActivation, ConfigReload, CycleEnd,
+		PairCycleCompleted, PairCycleFailed, StrategyFired,
+		OrderPlaced, OrderFilled, OrderCancelled, OutcomeMessage,
+		RemoteAction:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event contains single event's data.
+type Event struct {
+	// Type specifies event's type.
+	Type Type `json:"event"`
+
+	// Timestamp specifies when the event was published.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Payload specifies event's type specific data.
+	Payload interface{} `json:"payload,omitempty"`
+}
+
+// StatePayload contains state change
+// event's data.
+type StatePayload struct {
+	// State specifies short description
+	// of the new state.
+	State string `json:"state"`
+
+	// Info specifies full state info (the same
+	// as returned by /workflow/state).
+	Info interface{} `json:"info"`
+}
+
+// CyclePayload contains pair's cycle
+// event's data.
+type CyclePayload struct {
+	Pair  string           `json:"pair"`
+	Cycle *pkg.StreamCycle `json:"cycle"`
+}
+
+// StrategyPayload contains strategy
+// event's data.
+type StrategyPayload struct {
+	Pair     string `json:"pair"`
+	Strategy string `json:"strategy"`
+}
+
+// OrderPayload contains order
+// event's data.
+type OrderPayload struct {
+	Pair   string          `json:"pair"`
+	ID     string          `json:"orderID"`
+	Side   string          `json:"side"`
+	Rate   decimal.Decimal `json:"rate"`
+	Amount decimal.Decimal `json:"amount"`
+
+	// Strategy specifies strategy which placed
+	// the order, empty if the order was
+	// placed by a side task.
+	Strategy string `json:"strategy,omitempty"`
+}
+
+// MessagePayload contains message
+// event's data.
+type MessagePayload struct {
+	Pair     string `json:"pair"`
+	Strategy string `json:"strategy"`
+	Msg      string `json:"msg"`
+}
+
+// ActionPayload contains remote
+// action event's data.
+type ActionPayload struct {
+	// Action specifies executed action's name.
+	Action string `json:"action"`
+
+	// Pair specifies pair of pair specific
+	// actions, empty otherwise.
+	Pair string `json:"pair,omitempty"`
+
+	// Source specifies remote controller which
+	// executed the action (e.g. telegram).
+	Source string `json:"source"`
+
+	// User specifies who executed the action.
+	User string `json:"user"`
+
+	// Result specifies action's result message.
+	Result string `json:"result"`
+}
+
+// Pair returns pair's code of pair specific
+// events, empty string otherwise.
+func (e Event) Pair() string {
+	switch p := e.Payload.(type) {
+	case CyclePayload:
+		return p.Pair
+	case StrategyPayload:
+		return p.Pair
+	case OrderPayload:
+		return p.Pair
+	case MessagePayload:
+		return p.Pair
+	case ActionPayload:
+		return p.Pair
+	default:
+		return ""
+	}
+}
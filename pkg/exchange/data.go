@@ -11,11 +11,13 @@ import (
 */
 
 // Data wraps all of the exchange
-// data (Candle, TickerData, previous buy price) into one struct.
+// data (Candle, TickerData, OrderBook, Trade, previous buy price) into one struct.
 type Data struct {
-	Ticker   TickerData
-	Candles  []Candle
-	BuyPrice decimal.Decimal
+	Ticker    TickerData
+	Candles   []Candle
+	OrderBook OrderBook
+	Trades    []Trade
+	BuyPrice  decimal.Decimal
 }
 
 func NewData(tick TickerData, can []Candle) Data {
@@ -91,6 +93,41 @@ func CandlePriceValid(price string) error {
 	}
 }
 
+const (
+	BaseVolume    = "base"
+	CounterVolume = "counter"
+)
+
+var (
+	ErrCandleVolumeInvalid = errors.New("candle volume type is invalid")
+)
+
+func (c *Candle) Volume(volume string) decimal.Decimal {
+	switch volume {
+	case BaseVolume:
+		return c.BaseVolume
+	case CounterVolume:
+		return c.CounterVolume
+	default:
+		return decimal.Zero
+	}
+}
+
+// TypicalPrice returns average of candle's high,
+// low and close prices.
+func (c *Candle) TypicalPrice() decimal.Decimal {
+	return c.High.Add(c.Low).Add(c.Close).Div(decimal.New(3, 0))
+}
+
+func CandleVolumeValid(volume string) error {
+	switch volume {
+	case BaseVolume, CounterVolume:
+		return nil
+	default:
+		return ErrCandleVolumeInvalid
+	}
+}
+
 /*
 	Ticker
 */
@@ -169,6 +206,113 @@ func (t *TickerData) MiscProp(prop string) decimal.Decimal {
 	}
 }
 
+/*
+	Order book
+*/
+
+// OrderBookEntry contains single order book
+// price level data.
+type OrderBookEntry struct {
+	// Rate specifies price level of the entry.
+	Rate decimal.Decimal `json:"rate"`
+
+	// Amount specifies total amount of base asset
+	// at the price level.
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// OrderBook contains order book data from the exchange.
+// Asks must be sorted by rate in ascending order and
+// bids in descending order (best rates first).
+type OrderBook struct {
+	Asks []OrderBookEntry `json:"asks"`
+	Bids []OrderBookEntry `json:"bids"`
+}
+
+// IsEmpty checks whether both sides of the order
+// book contain at least one entry.
+func (o *OrderBook) IsEmpty() bool {
+	return len(o.Asks) <= 0 || len(o.Bids) <= 0
+}
+
+// Spread returns difference between the best
+// ask and bid rates.
+func (o *OrderBook) Spread() decimal.Decimal {
+	if o.IsEmpty() {
+		return decimal.Zero
+	}
+	return o.Asks[0].Rate.Sub(o.Bids[0].Rate)
+}
+
+// MidPrice returns average of the best
+// ask and bid rates.
+func (o *OrderBook) MidPrice() decimal.Decimal {
+	if o.IsEmpty() {
+		return decimal.Zero
+	}
+	return o.Asks[0].Rate.Add(o.Bids[0].Rate).Div(decimal.New(2, 0))
+}
+
+// AsksVolume returns total amount of asks which rates
+// are not further than the specified percent from the mid price.
+func (o *OrderBook) AsksVolume(percent decimal.Decimal) decimal.Decimal {
+	limit := o.MidPrice().Mul(decimal.New(100, 0).Add(percent)).Div(decimal.New(100, 0))
+
+	var res decimal.Decimal
+	for _, e := range o.Asks {
+		if e.Rate.GreaterThan(limit) {
+			break
+		}
+		res = res.Add(e.Amount)
+	}
+
+	return res
+}
+
+// BidsVolume returns total amount of bids which rates
+// are not further than the specified percent from the mid price.
+func (o *OrderBook) BidsVolume(percent decimal.Decimal) decimal.Decimal {
+	limit := o.MidPrice().Mul(decimal.New(100, 0).Sub(percent)).Div(decimal.New(100, 0))
+
+	var res decimal.Decimal
+	for _, e := range o.Bids {
+		if e.Rate.LessThan(limit) {
+			break
+		}
+		res = res.Add(e.Amount)
+	}
+
+	return res
+}
+
+/*
+	Trade
+*/
+
+// Trade contains single public trade data
+// returned from exchange.
+type Trade struct {
+	// Timestamp specifies trade execution time.
+	Timestamp time.Time `json:"timestamp"`
+
+	// ID specifies trade id (format might be different on each exchange).
+	ID string `json:"tradeID"`
+
+	// Rate specifies price of coin in counter asset.
+	Rate decimal.Decimal `json:"rate"`
+
+	// Amount specifies total amount of base asset.
+	Amount decimal.Decimal `json:"amount"`
+
+	// Side specifies taker's side (buy or sell).
+	Side string `json:"side"`
+}
+
+// Total returns total trade value (rate * amount).
+func (t *Trade) Total() decimal.Decimal {
+	return t.Rate.Mul(t.Amount)
+}
+
 /*
 	Order
 */
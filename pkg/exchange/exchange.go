@@ -77,6 +77,16 @@ type ExchangeConn interface {
 	// If latest candles data is needed, pass zero-value end parameter.
 	GetCandles(pair asset.Pair, interval int, end time.Time, limit int) ([]Candle, error)
 
+	// GetOrderBook retrieves specific pair order book data from the
+	// exchange driver. Depth specifies how many price levels of
+	// each side should be returned.
+	GetOrderBook(pair asset.Pair, depth int) (OrderBook, error)
+
+	// GetTrades retrieves specific pair recent public trades from the
+	// exchange driver. Only trades executed after start are returned,
+	// sorted by timestamp in ascending order.
+	GetTrades(pair asset.Pair, start time.Time) ([]Trade, error)
+
 	// GetBalances retrieves balances from the exchange driver.
 	GetBalances() (map[string]decimal.Decimal, error)
 
@@ -134,7 +144,8 @@ type ExchangeClient struct {
 func New(timeout int64) *ExchangeClient {
 	return &ExchangeClient{
 		client: &http.Client{
-			Timeout: time.Second * time.Duration(timeout),
+			Timeout:   time.Second * time.Duration(timeout),
+			Transport: metricsTransport{next: http.DefaultTransport},
 		},
 	}
 }
@@ -424,6 +435,88 @@ func (e *ExchangeClient) GetCandles(pair asset.Pair, interval int, end time.Time
 	return candles, nil
 }
 
+func (e *ExchangeClient) GetOrderBook(pair asset.Pair, depth int) (OrderBook, error) {
+	if e.driverAddr.String() == "" {
+		return OrderBook{}, ErrExchangeDriverAddrInvalid
+	}
+
+	if err := pair.RequireValid(); err != nil {
+		return OrderBook{}, NewError(err)
+	}
+
+	if depth <= 0 {
+		return OrderBook{}, NewPlainError("depth is invalid", 0)
+	}
+
+	u := e.driverAddr
+	u.Path = "orderbook"
+	q := u.Query()
+	q.Set("pair", pair.String())
+	q.Set("depth", fmt.Sprint(depth))
+	u.RawQuery = q.Encode()
+
+	resp, err := e.client.Get(u.String())
+	if err != nil {
+		return OrderBook{}, NewError(err)
+	}
+
+	book := OrderBook{}
+
+	if err = e.decodeResp(resp, &book); err != nil {
+		return OrderBook{}, err
+	}
+
+	if len(book.Asks) > depth {
+		book.Asks = book.Asks[:depth]
+	}
+
+	if len(book.Bids) > depth {
+		book.Bids = book.Bids[:depth]
+	}
+
+	return book, nil
+}
+
+func (e *ExchangeClient) GetTrades(pair asset.Pair, start time.Time) ([]Trade, error) {
+	if e.driverAddr.String() == "" {
+		return nil, ErrExchangeDriverAddrInvalid
+	}
+
+	if err := pair.RequireValid(); err != nil {
+		return nil, NewError(err)
+	}
+
+	u := e.driverAddr
+	u.Path = "trades"
+	q := u.Query()
+	q.Set("pair", pair.String())
+	if !start.IsZero() {
+		q.Set("start", start.Format(time.RFC3339))
+	}
+	u.RawQuery = q.Encode()
+
+	resp, err := e.client.Get(u.String())
+	if err != nil {
+		return nil, NewError(err)
+	}
+
+	trades := make([]Trade, 0)
+
+	if err = e.decodeResp(resp, &trades); err != nil {
+		return nil, err
+	}
+
+	res := make([]Trade, 0, len(trades))
+	for _, t := range trades {
+		if t.Timestamp.Before(start) {
+			continue
+		}
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
 func (e *ExchangeClient) GetBalances() (map[string]decimal.Decimal, error) {
 	if e.driverAddr.String() == "" {
 		return nil, ErrExchangeDriverAddrInvalid
@@ -0,0 +1,27 @@
+package exchange
+
+import (
+	"eonbot/pkg/metrics"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// metricsTransport records exchange driver requests'
+// latency and errors count per endpoint.
+type metricsTransport struct {
+	next http.RoundTripper
+}
+
+func (t metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	endpoint := strings.Trim(req.URL.Path, "/")
+	metrics.ExchangeRequestDuration.Observe(time.Since(started).Seconds(), endpoint)
+	if err != nil || resp.StatusCode >= 400 {
+		metrics.ExchangeRequestErrors.Inc(endpoint)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Default specifies registry used by
+// all bot process metrics.
+var Default = NewRegistry()
+
+// Order events used by Orders metric.
+const (
+	OrderPlaced    = "placed"
+	OrderFilled    = "filled"
+	OrderCancelled = "cancelled"
+)
+
+var (
+	// CycleDuration specifies how long pairs'
+	// stream cycles take.
+	CycleDuration = Default.NewHistogramVec("eonbot_cycle_duration_seconds",
+		"Duration of pair stream cycles.", DefaultBuckets, "pair")
+
+	// ExchangeRequestDuration specifies how long exchange
+	// driver requests take.
+	ExchangeRequestDuration = Default.NewHistogramVec("eonbot_exchange_request_duration_seconds",
+		"Duration of exchange driver requests.", DefaultBuckets, "endpoint")
+
+	// ExchangeRequestErrors specifies how many exchange driver
+	// requests failed or returned error status code.
+	ExchangeRequestErrors = Default.NewCounterVec("eonbot_exchange_request_errors_total",
+		"Count of failed exchange driver requests.", "endpoint")
+
+	// Orders specifies how many orders were placed,
+	// filled and cancelled by the bot.
+	Orders = Default.NewCounterVec("eonbot_orders_total",
+		"Count of orders placed, filled and cancelled by the bot.", "pair", "side", "event")
+
+	// CooldownActivations specifies how many times
+	// exchange cooldown was activated.
+	CooldownActivations = Default.NewCounterVec("eonbot_cooldown_activations_total",
+		"Count of exchange cooldown activations.")
+
+	// Balances specifies assets' balances retrieved
+	// during the latest cycle.
+	Balances = Default.NewGaugeVec("eonbot_balance",
+		"Asset balance retrieved during the latest cycle.", "asset")
+
+	// StrategyConditionsMet specifies whether strategy's conditions
+	// were met during the latest cycle (1) or not (0).
+	StrategyConditionsMet = Default.NewGaugeVec("eonbot_strategy_conditions_met",
+		"Whether strategy's conditions were met during the latest cycle.", "pair", "strategy")
+)
+
+// Server serves Default registry's
+// metrics over http.
+type Server struct {
+	serv *http.Server
+}
+
+// NewServer creates new metrics server and starts
+// listening on the specified port.
+func NewServer(port int) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Default.Handler())
+
+	s := &Server{
+		serv: &http.Server{
+			Addr:    fmt.Sprintf(":%d", port),
+			Handler: mux,
+		},
+	}
+
+	go func() {
+		err := s.serv.ListenAndServe()
+		if err != http.ErrServerClosed {
+			logrus.WithField("action", "metrics requests handling").Error(err)
+		}
+	}()
+
+	return s
+}
+
+// Stop shuts down metrics server.
+func (s *Server) Stop() {
+	if s.serv != nil {
+		s.serv.Shutdown(context.TODO())
+	}
+}
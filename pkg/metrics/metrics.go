@@ -0,0 +1,308 @@
+// Package metrics implements a minimal metrics registry which
+// exposes collected values in the Prometheus text format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	counterType   = "counter"
+	gaugeType     = "gauge"
+	histogramType = "histogram"
+)
+
+// DefaultBuckets specifies histogram buckets (in seconds)
+// suitable for request and cycle durations.
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Registry holds all registered metrics and
+// writes them out on request.
+type Registry struct {
+	mu      sync.RWMutex
+	metrics []*metric
+}
+
+// NewRegistry creates new empty registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// CounterVec is a counter with a set of labels. Its
+// values can only increase.
+type CounterVec struct {
+	m *metric
+}
+
+// GaugeVec is a gauge with a set of labels. Its
+// values can be set to any number.
+type GaugeVec struct {
+	m *metric
+}
+
+// HistogramVec is a histogram with a set of labels. It
+// counts observed values in configurable buckets.
+type HistogramVec struct {
+	m *metric
+}
+
+// NewCounterVec creates and registers new counter.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{m: r.register(name, help, counterType, nil, labels)}
+}
+
+// NewGaugeVec creates and registers new gauge.
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{m: r.register(name, help, gaugeType, nil, labels)}
+}
+
+// NewHistogramVec creates and registers new histogram. Buckets
+// must be sorted in increasing order.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	return &HistogramVec{m: r.register(name, help, histogramType, buckets, labels)}
+}
+
+// Inc increments counter's value by 1.
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add increases counter's value by v. Negative
+// values are ignored.
+func (c *CounterVec) Add(v float64, values ...string) {
+	if v < 0 {
+		return
+	}
+
+	c.m.update(values, func(s *series) {
+		s.value += v
+	})
+}
+
+// Set sets gauge's value.
+func (g *GaugeVec) Set(v float64, values ...string) {
+	g.m.update(values, func(s *series) {
+		s.value = v
+	})
+}
+
+// Delete removes gauge's value with the
+// specified label values.
+func (g *GaugeVec) Delete(values ...string) {
+	g.m.mu.Lock()
+	delete(g.m.series, seriesKey(values))
+	g.m.mu.Unlock()
+}
+
+// Observe adds a single value to the histogram.
+func (h *HistogramVec) Observe(v float64, values ...string) {
+	h.m.update(values, func(s *series) {
+		for i, b := range h.m.buckets {
+			if v <= b {
+				s.counts[i]++
+			}
+		}
+		s.sum += v
+		s.count++
+	})
+}
+
+// WriteTo writes all registered metrics in the
+// Prometheus text format.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	cw := &countWriter{w: bw}
+
+	r.mu.RLock()
+	metrics := make([]*metric, len(r.metrics))
+	copy(metrics, r.metrics)
+	r.mu.RUnlock()
+
+	for _, m := range metrics {
+		m.write(cw)
+	}
+
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+
+	return cw.n, bw.Flush()
+}
+
+// Handler returns http handler which responds
+// with all registered metrics.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.WriteTo(w)
+	})
+}
+
+func (r *Registry) register(name, help, kind string, buckets []float64, labels []string) *metric {
+	m := &metric{
+		name:    name,
+		help:    help,
+		kind:    kind,
+		labels:  labels,
+		buckets: buckets,
+		series:  make(map[string]*series),
+	}
+
+	r.mu.Lock()
+	r.metrics = append(r.metrics, m)
+	r.mu.Unlock()
+
+	return m
+}
+
+// metric holds a single metric's description and
+// all of its values grouped by label values.
+type metric struct {
+	name    string
+	help    string
+	kind    string
+	labels  []string
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+type series struct {
+	values []string
+
+	// value is used by counters and gauges.
+	value float64
+
+	// counts, sum and count are used by histograms.
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// update finds or creates series with the specified label values
+// and applies fn to it. Label values count must match
+// the metric's labels count.
+func (m *metric) update(values []string, fn func(s *series)) {
+	if len(values) != len(m.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", m.name, len(m.labels), len(values)))
+	}
+
+	key := seriesKey(values)
+
+	m.mu.Lock()
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{values: append([]string(nil), values...)}
+		if m.kind == histogramType {
+			s.counts = make([]uint64, len(m.buckets))
+		}
+		m.series[key] = s
+	}
+	fn(s)
+	m.mu.Unlock()
+}
+
+func (m *metric) write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", m.name, escape(m.help, false))
+	fmt.Fprintf(w, "# TYPE %s %s\n", m.name, m.kind)
+
+	keys := make([]string, 0, len(m.series))
+	for k := range m.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		s := m.series[k]
+		if m.kind != histogramType {
+			fmt.Fprintf(w, "%s%s %s\n", m.name, labelsString(m.labels, s.values, ""), formatFloat(s.value))
+			continue
+		}
+
+		for i, b := range m.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", m.name, labelsString(m.labels, s.values, formatFloat(b)), s.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", m.name, labelsString(m.labels, s.values, "+Inf"), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", m.name, labelsString(m.labels, s.values, ""), formatFloat(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", m.name, labelsString(m.labels, s.values, ""), s.count)
+	}
+}
+
+/*
+	helpers
+*/
+
+func seriesKey(values []string) string {
+	return strings.Join(values, "\xff")
+}
+
+// labelsString formats label pairs, le is added as
+// an additional label when not empty.
+func labelsString(names, values []string, le string) string {
+	pairs := make([]string, 0, len(names)+1)
+	for i, n := range names {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, n, escape(values[i], true)))
+	}
+
+	if le != "" {
+		pairs = append(pairs, fmt.Sprintf(`le="%s"`, le))
+	}
+
+	if len(pairs) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func escape(s string, quote bool) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	if quote {
+		s = strings.Replace(s, `"`, `\"`, -1)
+	}
+	return s
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// countWriter counts written bytes and
+// retains the first write error.
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
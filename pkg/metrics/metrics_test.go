@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryWriteTo(t *testing.T) {
+	r := NewRegistry()
+
+	c := r.NewCounterVec("test_total", "Test counter.", "pair")
+	c.Inc("ETH_BTC")
+	c.Add(2, "ETH_BTC")
+	c.Add(-1, "ETH_BTC")
+	c.Inc("DGB_BTC")
+
+	g := r.NewGaugeVec("test_gauge", "Test gauge.", "name")
+	g.Set(1.5, `a"b\c`)
+	g.Set(3, "removed")
+	g.Delete("removed")
+
+	h := r.NewHistogramVec("test_seconds", "Test histogram.", []float64{0.5, 1})
+	h.Observe(0.25)
+	h.Observe(0.75)
+	h.Observe(2)
+
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t, `# HELP test_total Test counter.
+# TYPE test_total counter
+test_total{pair="DGB_BTC"} 1
+test_total{pair="ETH_BTC"} 3
+# HELP test_gauge Test gauge.
+# TYPE test_gauge gauge
+test_gauge{name="a\"b\\c"} 1.5
+# HELP test_seconds Test histogram.
+# TYPE test_seconds histogram
+test_seconds_bucket{le="0.5"} 1
+test_seconds_bucket{le="1"} 2
+test_seconds_bucket{le="+Inf"} 3
+test_seconds_sum 3
+test_seconds_count 3
+`, buf.String())
+}
+
+func TestRegistryLabelsMismatch(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("test_total", "Test counter.", "pair", "side")
+	assert.Panics(t, func() { c.Inc("ETH_BTC") })
+}
+
+func TestRegistryHandler(t *testing.T) {
+	r := NewRegistry()
+	r.NewGaugeVec("test_gauge", "Test gauge.").Set(1)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	assert.Contains(t, rec.Body.String(), "test_gauge 1\n")
+}
@@ -0,0 +1,321 @@
+// Package email implements email notifications: immediate
+// events messages and periodic orders / PnL digests.
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+	"eonbot/pkg/report"
+	"eonbot/pkg/settings"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const dialTimeout = time.Second * 15
+
+// defaultImmediate specifies events which are sent
+// immediately when not specified in settings.
+var defaultImmediate = []event.Type{event.StateChange, event.CooldownActivation}
+
+// Email sends notifications to the
+// configured recipients.
+type Email struct {
+	conf settings.Email
+	db   db.Manager
+
+	immediate []event.Type
+	templates templates
+
+	// now returns current time, it is replaced
+	// in tests.
+	now func() time.Time
+
+	stop chan struct{}
+}
+
+type templates struct {
+	immediateSubject *template.Template
+	immediate        *template.Template
+	digestSubject    *template.Template
+	digest           *template.Template
+}
+
+// immediateData is passed to immediate
+// messages' templates.
+type immediateData struct {
+	event.Event
+
+	// Pair specifies pair of pair specific
+	// events, empty otherwise.
+	Pair string
+
+	// Details specifies indented JSON
+	// representation of the payload.
+	Details string
+}
+
+// Digest is passed to digest
+// messages' templates.
+type Digest struct {
+	// Period specifies digest period (hourly or daily).
+	Period string
+
+	Start time.Time
+	End   time.Time
+
+	// Pairs specifies summaries of pairs which
+	// had orders during the period.
+	Pairs []report.PairSummary
+}
+
+// New creates new email notifier and starts
+// digest sending, if it's enabled.
+func New(conf settings.Email, db db.Manager) (*Email, error) {
+	e := &Email{
+		conf:      conf,
+		db:        db,
+		immediate: conf.Immediate,
+		now:       time.Now,
+		stop:      make(chan struct{}),
+	}
+
+	if e.immediate == nil {
+		e.immediate = defaultImmediate
+	}
+
+	if e.conf.Security == "" {
+		e.conf.Security = settings.EmailSecurityStartTLS
+	}
+
+	var err error
+	parse := func(name, custom, def string) *template.Template {
+		if err != nil {
+			return nil
+		}
+
+		if custom == "" {
+			custom = def
+		}
+
+		var t *template.Template
+		t, err = template.New(name).Parse(custom)
+		return t
+	}
+
+	e.templates = templates{
+		immediateSubject: parse("immediate subject", conf.Templates.ImmediateSubject, immediateSubjectTmpl),
+		immediate:        parse("immediate", conf.Templates.Immediate, immediateTmpl),
+		digestSubject:    parse("digest subject", conf.Templates.DigestSubject, digestSubjectTmpl),
+		digest:           parse("digest", conf.Templates.Digest, digestTmpl),
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if e.conf.Digest != "" {
+		go e.digestLoop()
+	}
+
+	return e, nil
+}
+
+// Accepts checks whether the event should
+// be sent immediately.
+func (e *Email) Accepts(ev event.Event) bool {
+	return event.Types(e.immediate...)(ev)
+}
+
+// Notify sends immediate event's message.
+func (e *Email) Notify(ev event.Event) error {
+	data := immediateData{Event: ev, Pair: ev.Pair()}
+	if ev.Payload != nil {
+		details, err := json.MarshalIndent(ev.Payload, "", "  ")
+		if err != nil {
+			return err
+		}
+		data.Details = string(details)
+	}
+
+	return e.sendTemplates(e.templates.immediateSubject, e.templates.immediate, data)
+}
+
+// Stop stops digest sending.
+func (e *Email) Stop() {
+	close(e.stop)
+}
+
+// digestLoop sends digests at the end of
+// each digest period until stopped.
+func (e *Email) digestLoop() {
+	for {
+		now := e.now().UTC()
+		end := nextDigest(now, e.conf.Digest)
+
+		select {
+		case <-time.After(end.Sub(now)):
+		case <-e.stop:
+			return
+		}
+
+		if err := e.sendDigest(prevDigest(end, e.conf.Digest), end); err != nil {
+			logrus.WithField("action", "email digest sending").Error(err)
+		}
+	}
+}
+
+// sendDigest collects orders from the db and sends
+// digest of the specified period.
+func (e *Email) sendDigest(start, end time.Time) error {
+	orders, err := e.db.Persistent().GetOrders(start.Add(-report.Lookback), end)
+	if err != nil && err != db.ErrDataNotFound {
+		return err
+	}
+
+	d := Digest{
+		Period: e.conf.Digest,
+		Start:  start,
+		End:    end,
+		Pairs:  report.Summarize(orders, start, end),
+	}
+
+	return e.sendTemplates(e.templates.digestSubject, e.templates.digest, d)
+}
+
+func (e *Email) sendTemplates(subject, body *template.Template, data interface{}) error {
+	var sb, bb bytes.Buffer
+	if err := subject.Execute(&sb, data); err != nil {
+		return err
+	}
+
+	if err := body.Execute(&bb, data); err != nil {
+		return err
+	}
+
+	return e.send(strings.TrimSpace(sb.String()), bb.String())
+}
+
+// send sends a plain text message to
+// all recipients.
+func (e *Email) send(subject, body string) error {
+	from, err := mail.ParseAddress(e.conf.From)
+	if err != nil {
+		return err
+	}
+
+	c, err := e.dial()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if e.conf.Security == settings.EmailSecurityStartTLS {
+		if err := c.StartTLS(&tls.Config{ServerName: e.conf.Host}); err != nil {
+			return err
+		}
+	}
+
+	if e.conf.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", e.conf.Username, e.conf.Password, e.conf.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(from.Address); err != nil {
+		return err
+	}
+
+	for _, r := range e.conf.Recipients {
+		addr, err := mail.ParseAddress(r)
+		if err != nil {
+			return err
+		}
+
+		if err := c.Rcpt(addr.Address); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(e.message(subject, body)); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// dial connects to the SMTP server.
+func (e *Email) dial() (*smtp.Client, error) {
+	addr := net.JoinHostPort(e.conf.Host, strconv.Itoa(e.conf.Port))
+	dialer := &net.Dialer{Timeout: dialTimeout}
+
+	var conn net.Conn
+	var err error
+	if e.conf.Security == settings.EmailSecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: e.conf.Host})
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := smtp.NewClient(conn, e.conf.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// message prepares message's headers and body.
+func (e *Email) message(subject, body string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", e.conf.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(e.conf.Recipients, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", e.now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(body)
+	return b.Bytes()
+}
+
+// nextDigest returns the end of the
+// current digest period.
+func nextDigest(now time.Time, period string) time.Time {
+	if period == settings.DigestHourly {
+		return now.Truncate(time.Hour).Add(time.Hour)
+	}
+	return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+}
+
+// prevDigest returns the start of the
+// digest period which ends at end.
+func prevDigest(end time.Time, period string) time.Time {
+	if period == settings.DigestHourly {
+		return end.Add(-time.Hour)
+	}
+	return end.AddDate(0, 0, -1)
+}
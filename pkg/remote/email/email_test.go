@@ -0,0 +1,222 @@
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"eonbot/pkg/event"
+	"eonbot/pkg/report"
+	"eonbot/pkg/settings"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// sink is a local SMTP server which accepts all
+// messages and records them.
+type sink struct {
+	ln net.Listener
+
+	mu       sync.Mutex
+	messages []sinkMessage
+}
+
+type sinkMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func newSink(t *testing.T) *sink {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &sink{ln: ln}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *sink) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *sink) close() {
+	s.ln.Close()
+}
+
+func (s *sink) received() []sinkMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]sinkMessage(nil), s.messages...)
+}
+
+func (s *sink) serve(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+
+	var msg sinkMessage
+	tp.PrintfLine("220 localhost ESMTP sink")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			msg = sinkMessage{from: strings.TrimSuffix(strings.TrimPrefix(line, "MAIL FROM:<"), ">")}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			msg.to = append(msg.to, strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">"))
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.data = string(data)
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func testConf(port int) settings.Email {
+	return settings.Email{
+		Enable:     true,
+		Host:       "127.0.0.1",
+		Port:       port,
+		Security:   settings.EmailSecurityNone,
+		From:       "EonBot <bot@example.com>",
+		Recipients: []string{"ops@example.com", "Trader <trader@example.com>"},
+	}
+}
+
+func TestEmailNotify(t *testing.T) {
+	s := newSink(t)
+	defer s.close()
+
+	e, err := New(testConf(s.port()), nil)
+	assert.Nil(t, err)
+	defer e.Stop()
+	e.now = func() time.Time { return time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+	ev := event.Event{
+		Type:      event.OrderFilled,
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Payload:   event.OrderPayload{Pair: "ETH_BTC", ID: "123", Side: "buy"},
+	}
+	assert.Nil(t, e.Notify(ev))
+
+	mm := s.received()
+	assert.Len(t, mm, 1)
+	assert.Equal(t, "bot@example.com", mm[0].from)
+	assert.Equal(t, []string{"ops@example.com", "trader@example.com"}, mm[0].to)
+
+	r := bufio.NewReader(strings.NewReader(mm[0].data))
+	tp := textproto.NewReader(r)
+	header, err := tp.ReadMIMEHeader()
+	assert.Nil(t, err)
+	assert.Equal(t, "EonBot: order-filled (ETH_BTC)", header.Get("Subject"))
+	assert.Equal(t, "EonBot <bot@example.com>", header.Get("From"))
+	assert.Equal(t, "ops@example.com, Trader <trader@example.com>", header.Get("To"))
+	assert.Contains(t, mm[0].data, "Event 'order-filled' occurred at 2020-01-01 12:00:00 UTC.")
+	assert.Contains(t, mm[0].data, `"orderID": "123"`)
+}
+
+func TestEmailCustomTemplates(t *testing.T) {
+	s := newSink(t)
+	defer s.close()
+
+	conf := testConf(s.port())
+	conf.Templates = settings.EmailTemplates{
+		ImmediateSubject: "[bot] {{.Type}}",
+		Immediate:        "state: {{.Payload.State}}",
+	}
+
+	e, err := New(conf, nil)
+	assert.Nil(t, err)
+	defer e.Stop()
+
+	assert.Nil(t, e.Notify(event.Event{Type: event.StateChange, Payload: event.StatePayload{State: "stopped"}}))
+
+	mm := s.received()
+	assert.Len(t, mm, 1)
+	assert.Contains(t, mm[0].data, "Subject: [bot] state-update\n")
+	assert.True(t, strings.HasSuffix(mm[0].data, "\n\nstate: stopped\n"))
+}
+
+func TestEmailAccepts(t *testing.T) {
+	e, err := New(testConf(25), nil)
+	assert.Nil(t, err)
+	defer e.Stop()
+	assert.True(t, e.Accepts(event.Event{Type: event.CooldownActivation}))
+	assert.False(t, e.Accepts(event.Event{Type: event.OrderFilled}))
+
+	conf := testConf(25)
+	conf.Immediate = []event.Type{}
+	e, err = New(conf, nil)
+	assert.Nil(t, err)
+	defer e.Stop()
+	assert.False(t, e.Accepts(event.Event{Type: event.CooldownActivation}))
+}
+
+func TestDigestPeriods(t *testing.T) {
+	now := time.Date(2020, 1, 31, 13, 25, 0, 0, time.UTC)
+
+	end := nextDigest(now, settings.DigestHourly)
+	assert.Equal(t, time.Date(2020, 1, 31, 14, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, time.Date(2020, 1, 31, 13, 0, 0, 0, time.UTC), prevDigest(end, settings.DigestHourly))
+
+	end = nextDigest(now, settings.DigestDaily)
+	assert.Equal(t, time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC), prevDigest(end, settings.DigestDaily))
+}
+
+func TestDigestTemplate(t *testing.T) {
+	tmpl := template.Must(template.New("digest").Parse(digestTmpl))
+
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, Digest{
+		Start: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
+		Pairs: []report.PairSummary{{Pair: "ETH_BTC", Buys: 1, Bought: decimal.New(32, 0), PnL: decimal.New(-2, 0)}},
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, b.String(), "from 2020-01-02 00:00 UTC to 2020-01-03 00:00 UTC")
+	assert.Contains(t, b.String(), "Buy orders: 1 (total: 32)")
+	assert.Contains(t, b.String(), "Realized PnL: -2")
+
+	b.Reset()
+	assert.Nil(t, tmpl.Execute(&b, Digest{}))
+	assert.Contains(t, b.String(), "No orders were filled.")
+}
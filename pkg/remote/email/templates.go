@@ -0,0 +1,28 @@
+package email
+
+// Default messages templates. Immediate templates receive event's
+// fields (.Type, .Timestamp, .Payload) with .Pair and .Details
+// (payload's JSON), digest templates receive Digest.
+const (
+	immediateSubjectTmpl = `EonBot: {{.Type}}{{if .Pair}} ({{.Pair}}){{end}}`
+
+	immediateTmpl = `Event '{{.Type}}' occurred at {{.Timestamp.Format "2006-01-02 15:04:05 MST"}}.
+{{if .Pair}}
+Pair: {{.Pair}}
+{{end}}{{if .Details}}
+Details:
+{{.Details}}
+{{end}}`
+
+	digestSubjectTmpl = `EonBot {{.Period}} digest`
+
+	digestTmpl = `Orders summary from {{.Start.Format "2006-01-02 15:04 MST"}} to {{.End.Format "2006-01-02 15:04 MST"}}:
+{{range .Pairs}}
+{{.Pair}}:
+    Buy orders: {{.Buys}} (total: {{.Bought}})
+    Sell orders: {{.Sells}} (total: {{.Sold}})
+    Realized PnL: {{.PnL}}
+{{else}}
+No orders were filled.
+{{end}}`
+)
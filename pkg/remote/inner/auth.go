@@ -0,0 +1,266 @@
+package inner
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"eonbot/pkg/db"
+	"eonbot/pkg/settings"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/go-chi/chi"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// tokenLen specifies generated API tokens' length.
+	tokenLen = 40
+
+	// tokenUserPrefix is prepended to token's name
+	// to identify requests authorized by it.
+	tokenUserPrefix = "token:"
+)
+
+// scopeLevels specifies scopes' privilege levels, higher level
+// scope is allowed to do everything lower level scopes can.
+var scopeLevels = map[string]int{
+	settings.InternalScopeReadOnly:    1,
+	settings.InternalScopeTrading:     2,
+	settings.InternalScopeConfigAdmin: 3,
+}
+
+// scopeAllows checks whether the scope is
+// allowed to do what required scope can.
+func scopeAllows(scope, required string) bool {
+	level, ok := scopeLevels[scope]
+	return ok && level >= scopeLevels[required]
+}
+
+type ctxKey int
+
+const authCtxKey ctxKey = iota
+
+// authInfo contains authorized
+// request's user and scope.
+type authInfo struct {
+	User  string
+	Scope string
+}
+
+// reqAuth returns request's auth info
+// set by the auth middleware.
+func reqAuth(r *http.Request) authInfo {
+	info, _ := r.Context().Value(authCtxKey).(authInfo)
+	return info
+}
+
+// auth authorizes requests either by API token (Bearer
+// authorization) or by RC user's credentials (Basic
+// authorization).
+func (i *Internal) auth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info, ok := i.authorize(r)
+		if !ok {
+			errorResp(w, errors.New("unauthorized - credentials are incorrect"), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), authCtxKey, info)))
+	})
+}
+
+func (i *Internal) authorize(r *http.Request) (authInfo, bool) {
+	if token := bearerToken(r); token != "" {
+		return i.authorizeToken(token)
+	}
+
+	user, pass, ok := r.BasicAuth()
+	if !ok {
+		return authInfo{}, false
+	}
+
+	scope, ok := i.bot.conf.RemoteConfig().Get().Internal.Authorize(user, pass)
+	if !ok {
+		return authInfo{}, false
+	}
+
+	return authInfo{User: user, Scope: scope}, true
+}
+
+// authorizeToken finds API token by its hash.
+func (i *Internal) authorizeToken(token string) (authInfo, bool) {
+	tokens, err := i.bot.db.Persistent().GetAPITokens()
+	if err != nil {
+		logrus.WithField("action", "api tokens retrieval").Error(err)
+		return authInfo{}, false
+	}
+
+	hash := hashToken(token)
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hash)) == 1 {
+			return authInfo{User: tokenUserPrefix + t.Name, Scope: t.Scope}, true
+		}
+	}
+
+	return authInfo{}, false
+}
+
+// requireScope allows only requests authorized with
+// the required or higher level scope.
+func requireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !scopeAllows(reqAuth(r).Scope, scope) {
+				errorResp(w, fmt.Errorf("forbidden - '%s' scope is required", scope), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerToken returns token from request's
+// Bearer authorization header.
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	h := r.Header.Get("Authorization")
+	if len(h) <= len(prefix) || !strings.EqualFold(h[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(h[len(prefix):])
+}
+
+// hashToken returns SHA-256 hex
+// encoded hash of the token.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+/*
+   API tokens management
+*/
+
+func (i *Internal) authRoutes() http.Handler {
+	router := chi.NewRouter()
+	router.Use(requireScope(settings.InternalScopeConfigAdmin))
+	router.Route("/tokens", func(r chi.Router) {
+		r.Get("/", i.apiTokens)
+		r.Post("/", i.createAPIToken)
+		r.Delete("/", i.removeAPIToken)
+	})
+	return router
+}
+
+// apiTokenResp contains API token's data
+// returned to RC, hash is never returned.
+type apiTokenResp struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Token is returned only when
+	// the token is created.
+	Token string `json:"token,omitempty"`
+}
+
+func newAPITokenResp(t db.APIToken) apiTokenResp {
+	return apiTokenResp{
+		ID:        t.ID,
+		Name:      t.Name,
+		Scope:     t.Scope,
+		CreatedBy: t.CreatedBy,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func (i *Internal) apiTokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := i.bot.db.Persistent().GetAPITokens()
+	if err != nil {
+		errorResp(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	sort.Slice(tokens, func(a, b int) bool {
+		return tokens[a].CreatedAt.Before(tokens[b].CreatedAt)
+	})
+
+	res := make([]apiTokenResp, 0, len(tokens))
+	for _, t := range tokens {
+		res = append(res, newAPITokenResp(t))
+	}
+
+	successfulJSONResp(w, res, http.StatusOK)
+}
+
+func (i *Internal) createAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name  string `json:"name"`
+		Scope string `json:"scope"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		jsonReqMalformed(w)
+		return
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		errorResp(w, errors.New("token name cannot be empty"), http.StatusBadRequest)
+		return
+	}
+
+	req.Scope = strings.ToLower(strings.TrimSpace(req.Scope))
+	if !settings.ValidInternalScope(req.Scope) {
+		errorResp(w, fmt.Errorf("token scope '%s' is invalid", req.Scope), http.StatusBadRequest)
+		return
+	}
+
+	token := uniuri.NewLen(tokenLen)
+	t := db.APIToken{
+		ID:        uniuri.NewLen(12),
+		Name:      req.Name,
+		Scope:     req.Scope,
+		Hash:      hashToken(token),
+		CreatedBy: reqAuth(r).User,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := i.bot.db.Persistent().SaveAPIToken(t); err != nil {
+		errorResp(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	res := newAPITokenResp(t)
+	res.Token = token
+	successfulJSONResp(w, res, http.StatusCreated)
+}
+
+func (i *Internal) removeAPIToken(w http.ResponseWriter, r *http.Request) {
+	var query struct {
+		ID string `schema:"id"`
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil || query.ID == "" {
+		reqMalformed(w)
+		return
+	}
+
+	if err := i.bot.db.Persistent().DeleteAPIToken(query.ID); err != nil {
+		errorResp(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	successfulEmptyResp(w, http.StatusOK)
+}
@@ -0,0 +1,102 @@
+package inner
+
+import (
+	"context"
+	"eonbot/pkg/settings"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeAllows(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Scope    string
+		Required string
+		Result   bool
+	}{
+		{
+			Name:     "Read-only as read-only",
+			Scope:    settings.InternalScopeReadOnly,
+			Required: settings.InternalScopeReadOnly,
+			Result:   true,
+		},
+		{
+			Name:     "Read-only as trading",
+			Scope:    settings.InternalScopeReadOnly,
+			Required: settings.InternalScopeTrading,
+			Result:   false,
+		},
+		{
+			Name:     "Trading as config-admin",
+			Scope:    settings.InternalScopeTrading,
+			Required: settings.InternalScopeConfigAdmin,
+			Result:   false,
+		},
+		{
+			Name:     "Config-admin as trading",
+			Scope:    settings.InternalScopeConfigAdmin,
+			Required: settings.InternalScopeTrading,
+			Result:   true,
+		},
+		{
+			Name:     "Missing scope",
+			Scope:    "",
+			Required: settings.InternalScopeReadOnly,
+			Result:   false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, v.Result, scopeAllows(v.Scope, v.Required))
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	handler := requireScope(settings.InternalScopeTrading)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(scope string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/workflow/start", nil)
+		req = req.WithContext(context.WithValue(req.Context(), authCtxKey, authInfo{User: "user", Scope: scope}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(settings.InternalScopeReadOnly)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.JSONEq(t, `{"error":"forbidden - 'trading' scope is required"}`, rec.Body.String())
+
+	assert.Equal(t, http.StatusOK, serve(settings.InternalScopeTrading).Code)
+	assert.Equal(t, http.StatusOK, serve(settings.InternalScopeConfigAdmin).Code)
+}
+
+func TestBearerToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Equal(t, "", bearerToken(req))
+
+	req.SetBasicAuth("user", "pass")
+	assert.Equal(t, "", bearerToken(req))
+
+	req.Header.Set("Authorization", "Bearer abc123")
+	assert.Equal(t, "abc123", bearerToken(req))
+
+	req.Header.Set("Authorization", "bearer abc123 ")
+	assert.Equal(t, "abc123", bearerToken(req))
+
+	req.Header.Set("Authorization", "Bearer ")
+	assert.Equal(t, "", bearerToken(req))
+}
+
+func TestHashToken(t *testing.T) {
+	assert.Equal(t, "6ca13d52ca70c883e0f0bb101e425a89e8624de51db2d2392593af6a84118090", hashToken("abc123"))
+	assert.NotEqual(t, hashToken("abc123"), hashToken("abc124"))
+}
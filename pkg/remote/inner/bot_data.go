@@ -3,6 +3,7 @@ package inner
 import (
 	"eonbot/pkg"
 	"eonbot/pkg/asset"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
 	"fmt"
 	"net/http"
@@ -30,6 +31,8 @@ func (i *Internal) botDataRoutes() http.Handler {
 		r.Get("/ids", i.cyclesIDs)
 	})
 
+	router.Get("/events", i.botEvents)
+
 	return router
 }
 
@@ -185,3 +188,39 @@ func (i *Internal) cyclesIDs(w http.ResponseWriter, r *http.Request) {
 
 	successfulJSONResp(w, ids, http.StatusOK)
 }
+
+/*
+   audit log events
+*/
+
+func (i *Internal) botEvents(w http.ResponseWriter, r *http.Request) {
+	var query struct {
+		Start time.Time    `schema:"start"`
+		End   time.Time    `schema:"end"`
+		Types []event.Type `schema:"type"`
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		reqMalformed(w)
+		return
+	}
+
+	events, err := i.bot.db.Persistent().GetEvents(query.Start, query.End)
+	if err != nil {
+		errorResp(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if len(query.Types) > 0 {
+		filter := event.Types(query.Types...)
+		filtered := make([]event.Event, 0, len(events))
+		for _, e := range events {
+			if filter(e) {
+				filtered = append(filtered, e)
+			}
+		}
+		events = filtered
+	}
+
+	successfulJSONResp(w, events, http.StatusOK)
+}
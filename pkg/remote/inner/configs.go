@@ -1,6 +1,7 @@
 package inner
 
 import (
+	"eonbot/pkg/settings"
 	"io/ioutil"
 	"net/http"
 
@@ -12,26 +13,32 @@ func (i *Internal) configsRoutes() http.Handler {
 
 	router.Get("/summary", i.summary)
 
-	router.Route("/main", func(r chi.Router) {
-		r.Put("/", i.mainUpload)
-		r.Get("/", i.mainDownload)
-	})
-
-	router.Route("/remote", func(r chi.Router) {
-		r.Put("/", i.remoteUpload)
-		r.Get("/", i.remoteDownload)
-	})
-
-	router.Route("/sub", func(r chi.Router) {
-		r.Put("/", i.subUpload)
-		r.Get("/", i.subDownload)
-		r.Delete("/", i.subRemove)
-	})
-
-	router.Route("/strategy", func(r chi.Router) {
-		r.Put("/", i.strategyUpload)
-		r.Get("/", i.strategyDownload)
-		r.Delete("/", i.strategyRemove)
+	// configs contain secrets, so only admins
+	// are allowed to see or change them.
+	router.Group(func(r chi.Router) {
+		r.Use(requireScope(settings.InternalScopeConfigAdmin))
+
+		r.Route("/main", func(r chi.Router) {
+			r.Put("/", i.mainUpload)
+			r.Get("/", i.mainDownload)
+		})
+
+		r.Route("/remote", func(r chi.Router) {
+			r.Put("/", i.remoteUpload)
+			r.Get("/", i.remoteDownload)
+		})
+
+		r.Route("/sub", func(r chi.Router) {
+			r.Put("/", i.subUpload)
+			r.Get("/", i.subDownload)
+			r.Delete("/", i.subRemove)
+		})
+
+		r.Route("/strategy", func(r chi.Router) {
+			r.Put("/", i.strategyUpload)
+			r.Get("/", i.strategyDownload)
+			r.Delete("/", i.strategyRemove)
+		})
 	})
 
 	return router
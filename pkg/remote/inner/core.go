@@ -6,14 +6,12 @@ import (
 	"eonbot/pkg/control"
 	"eonbot/pkg/db"
 	"eonbot/pkg/exchange"
-	"errors"
 	"fmt"
 	"net/http"
 	"sync"
 
 	"github.com/go-chi/chi"
 	"github.com/gorilla/schema"
-	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 )
 
@@ -37,7 +35,7 @@ type Internal struct {
 		}
 		ws struct {
 			sync.RWMutex
-			clients map[string]*websocket.Conn
+			clients map[string]*wsClient
 		}
 	}
 }
@@ -48,12 +46,12 @@ func New(conf config.Manager, control control.Controller, db db.Manager, exchang
 	inter.bot.control = control
 	inter.bot.db = db
 	inter.bot.exchange = exchange
-	inter.conn.ws.clients = make(map[string]*websocket.Conn)
+	inter.conn.ws.clients = make(map[string]*wsClient)
 
 	router := chi.NewRouter()
 
 	router.Group(func(r chi.Router) {
-		r.Use(inter.basicAuth)
+		r.Use(inter.auth)
 		// bot data endpoints
 		r.Mount("/bot", inter.botDataRoutes())
 
@@ -66,6 +64,9 @@ func New(conf config.Manager, control control.Controller, db db.Manager, exchang
 		// exchange endpoints
 		r.Mount("/exchange", inter.exchangeRoutes())
 
+		// API tokens endpoints
+		r.Mount("/auth", inter.authRoutes())
+
 		// websockets handler
 		r.HandleFunc("/ws", inter.wsHandler)
 	})
@@ -90,28 +91,7 @@ func (i *Internal) Stop() {
 		i.conn.http.serv.Shutdown(context.TODO())
 	}
 
-	i.getWSClients(func(cc map[string]*websocket.Conn) {
-		if len(cc) > 0 {
-			for id := range cc {
-				i.removeWSClient(id)
-			}
-		}
-	})
-}
-
-func (i *Internal) basicAuth(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		user, pass, ok := r.BasicAuth()
-		if !ok || !i.canAuthorize(user, pass) {
-			errorResp(w, errors.New("unauthorized - username or password is incorrect"), http.StatusUnauthorized)
-			return
-		}
-
-		next.ServeHTTP(w, r)
-	})
-}
-
-func (i *Internal) canAuthorize(username, password string) bool {
-	return i.bot.conf.RemoteConfig().Get().Internal.Username == username &&
-		i.bot.conf.RemoteConfig().Get().Internal.Password == password
+	for _, id := range i.wsClientsIDs() {
+		i.removeWSClient(id)
+	}
 }
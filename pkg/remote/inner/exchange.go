@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"eonbot/pkg/asset"
 	"eonbot/pkg/exchange"
+	"eonbot/pkg/settings"
 	"net/http"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 func (i *Internal) exchangeRoutes() http.Handler {
 	router := chi.NewRouter()
 	router.Route("/api-info", func(r chi.Router) {
+		r.Use(requireScope(settings.InternalScopeConfigAdmin))
 		r.Post("/", i.updateAPIInfo)
 		r.Get("/", i.apiInfo)
 	})
@@ -21,6 +23,8 @@ func (i *Internal) exchangeRoutes() http.Handler {
 	router.Get("/intervals", i.intervals)
 	router.Get("/ticker", i.ticker)
 	router.Get("/candles", i.candles)
+	router.Get("/orderbook", i.orderBook)
+	router.Get("/trades", i.trades)
 	router.Get("/balances", i.balances)
 	router.Get("/open-orders", i.openOrders)
 	router.Get("/order-history", i.orderHistory)
@@ -134,6 +138,46 @@ func (i *Internal) candles(w http.ResponseWriter, r *http.Request) {
 
 }
 
+func (i *Internal) orderBook(w http.ResponseWriter, r *http.Request) {
+	var query struct {
+		Pair  asset.Pair `schema:"pair"`
+		Depth int        `schema:"depth"`
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		reqMalformed(w)
+		return
+	}
+
+	book, err := i.bot.exchange.GetOrderBook(query.Pair, query.Depth)
+	if err != nil {
+		errorResp(w, err, http.StatusBadRequest)
+		return
+	}
+
+	successfulJSONResp(w, book, http.StatusOK)
+}
+
+func (i *Internal) trades(w http.ResponseWriter, r *http.Request) {
+	var query struct {
+		Pair  asset.Pair `schema:"pair"`
+		Start time.Time  `schema:"start"`
+	}
+
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		reqMalformed(w)
+		return
+	}
+
+	trades, err := i.bot.exchange.GetTrades(query.Pair, query.Start)
+	if err != nil {
+		errorResp(w, err, http.StatusBadRequest)
+		return
+	}
+
+	successfulJSONResp(w, trades, http.StatusOK)
+}
+
 func (i *Internal) balances(w http.ResponseWriter, r *http.Request) {
 	balances, err := i.bot.exchange.GetBalances()
 	if err != nil {
@@ -1,9 +1,13 @@
 package inner
 
 import (
+	"encoding/json"
+	"eonbot/pkg/asset"
+	"eonbot/pkg/event"
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/dchest/uniuri"
@@ -11,7 +15,191 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func (i *Internal) addWSClient(c *websocket.Conn) string {
+const (
+	// wsWriteWait specifies how long a single
+	// message writing can take.
+	wsWriteWait = time.Second * 10
+
+	// wsPongWait specifies how long to wait for the
+	// client's pong (or any other message) before
+	// the connection is considered dead.
+	wsPongWait = time.Second * 60
+
+	// wsPingPeriod specifies how often pings are
+	// sent to the client. Must be less than wsPongWait.
+	wsPingPeriod = wsPongWait * 9 / 10
+)
+
+// client messages actions.
+const (
+	wsSubscribe   = "subscribe"
+	wsUnsubscribe = "unsubscribe"
+)
+
+// replies to client messages.
+const (
+	// wsSubscription is sent after successful subscription
+	// update. Payload: wsSubscriptions.
+	wsSubscription event.Type = "subscription"
+
+	// wsError is sent when client's message is
+	// invalid. Payload: wsErrorPayload.
+	wsError event.Type = "error"
+)
+
+type wsErrorPayload struct {
+	Error string `json:"error"`
+}
+
+// wsClient contains single websocket
+// client's connection and subscriptions.
+type wsClient struct {
+	conn *websocket.Conn
+
+	// writeMu ensures that only one message
+	// is written at once.
+	writeMu sync.Mutex
+
+	// stop is closed when the client is removed.
+	stop chan struct{}
+
+	subsMu sync.RWMutex
+	pairs  map[string]struct{}
+	events map[event.Type]struct{}
+}
+
+// wsRequest contains client's subscription message.
+type wsRequest struct {
+	Action string       `json:"action"`
+	Pairs  []asset.Pair `json:"pairs"`
+	Events []event.Type `json:"events"`
+}
+
+// wsSubscriptions contains client's active
+// subscriptions.
+type wsSubscriptions struct {
+	Pairs  []string     `json:"pairs"`
+	Events []event.Type `json:"events"`
+}
+
+func newWSClient(conn *websocket.Conn) *wsClient {
+	return &wsClient{
+		conn:   conn,
+		stop:   make(chan struct{}),
+		pairs:  make(map[string]struct{}),
+		events: make(map[event.Type]struct{}),
+	}
+}
+
+// accepts checks whether the event matches client's subscriptions.
+// Empty pairs / events subscriptions accept everything, pairs
+// subscriptions are applied only to pair specific events.
+func (c *wsClient) accepts(e event.Event) bool {
+	c.subsMu.RLock()
+	defer c.subsMu.RUnlock()
+
+	if len(c.events) > 0 {
+		if _, ok := c.events[e.Type]; !ok {
+			return false
+		}
+	}
+
+	if pair := e.Pair(); pair != "" && len(c.pairs) > 0 {
+		if _, ok := c.pairs[pair]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// update applies subscription request and
+// returns active subscriptions.
+func (c *wsClient) update(req wsRequest) (wsSubscriptions, error) {
+	for _, e := range req.Events {
+		if !e.IsValid() {
+			return wsSubscriptions{}, fmt.Errorf("event type '%s' is invalid", e)
+		}
+	}
+
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+
+	switch req.Action {
+	case wsSubscribe:
+		for _, p := range req.Pairs {
+			c.pairs[p.String()] = struct{}{}
+		}
+		for _, e := range req.Events {
+			c.events[e] = struct{}{}
+		}
+	case wsUnsubscribe:
+		for _, p := range req.Pairs {
+			delete(c.pairs, p.String())
+		}
+		for _, e := range req.Events {
+			delete(c.events, e)
+		}
+	default:
+		return wsSubscriptions{}, fmt.Errorf("action '%s' is invalid", req.Action)
+	}
+
+	subs := wsSubscriptions{
+		Pairs:  make([]string, 0, len(c.pairs)),
+		Events: make([]event.Type, 0, len(c.events)),
+	}
+
+	for p := range c.pairs {
+		subs.Pairs = append(subs.Pairs, p)
+	}
+
+	for e := range c.events {
+		subs.Events = append(subs.Events, e)
+	}
+
+	return subs, nil
+}
+
+// write sends JSON message to the client.
+func (c *wsClient) write(v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	return c.conn.WriteJSON(v)
+}
+
+// reply sends reply to client's message.
+func (c *wsClient) reply(t event.Type, payload interface{}) {
+	err := c.write(event.Event{
+		Type:      t,
+		Timestamp: time.Now().UTC(),
+		Payload:   payload,
+	})
+	if err != nil {
+		logrus.WithField("action", "websocket reply writing").Error(err)
+	}
+}
+
+// ping sends pings to the client until
+// it is removed.
+func (c *wsClient) ping() error {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				return err
+			}
+		case <-c.stop:
+			return nil
+		}
+	}
+}
+
+func (i *Internal) addWSClient(c *wsClient) string {
 	id := uniuri.NewLen(20) + strconv.FormatInt(time.Now().UTC().UnixNano(), 10)
 	i.conn.ws.Lock()
 	i.conn.ws.clients[id] = c
@@ -21,26 +209,38 @@ func (i *Internal) addWSClient(c *websocket.Conn) string {
 
 func (i *Internal) removeWSClient(id string) {
 	i.conn.ws.Lock()
-	if _, ok := i.conn.ws.clients[id]; ok {
-		i.conn.ws.clients[id].Close() // pointer
+	if c, ok := i.conn.ws.clients[id]; ok {
+		close(c.stop)
+		c.conn.Close()
 	}
 	delete(i.conn.ws.clients, id)
 	i.conn.ws.Unlock()
 }
 
-func (i *Internal) getWSClients(f func(cc map[string]*websocket.Conn)) {
+func (i *Internal) getWSClients(f func(cc map[string]*wsClient)) {
 	i.conn.ws.RLock()
 	f(i.conn.ws.clients)
 	i.conn.ws.RUnlock()
 }
 
-func (i *Internal) getWSClient(id string) (c *websocket.Conn) {
+func (i *Internal) getWSClient(id string) (c *wsClient) {
 	i.conn.ws.RLock()
 	c = i.conn.ws.clients[id]
 	i.conn.ws.RUnlock()
 	return c
 }
 
+// wsClientsIDs returns ids of all connected clients.
+func (i *Internal) wsClientsIDs() []string {
+	var ids []string
+	i.getWSClients(func(cc map[string]*wsClient) {
+		for id := range cc {
+			ids = append(ids, id)
+		}
+	})
+	return ids
+}
+
 var upgrader = websocket.Upgrader{}
 
 func (i *Internal) wsHandler(w http.ResponseWriter, r *http.Request) {
@@ -50,36 +250,75 @@ func (i *Internal) wsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id := i.addWSClient(conn)
+	c := newWSClient(conn)
+	id := i.addWSClient(c)
+
+	// client is considered dead if nothing
+	// is received until read deadline.
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	go func() {
+		if err := c.ping(); err != nil {
+			logrus.WithField("action", "websocket ping writing").Debug(err)
+			i.removeWSClient(id)
+		}
+	}()
 
 	for {
-		_, _, err := conn.NextReader()
+		_, msg, err := conn.ReadMessage()
 		if err != nil {
-			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			// client might have been already removed
+			// by failed ping / publishing.
+			if i.getWSClient(id) != nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
 				logrus.WithField("action", "websocket data reading").Error(err)
 			}
 			i.removeWSClient(id)
 			return
 		}
+
+		conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		var req wsRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			c.reply(wsError, wsErrorPayload{Error: "message is malformed"})
+			continue
+		}
+
+		subs, err := c.update(req)
+		if err != nil {
+			c.reply(wsError, wsErrorPayload{Error: err.Error()})
+			continue
+		}
+
+		c.reply(wsSubscription, subs)
 	}
 }
 
-const (
-	StateChangeEvent        = "state-update"
-	PairCycleEndEvent       = "pair-cycle-end"
-	CooldownActivationEvent = "cooldown-activation"
-)
-
-func (i *Internal) PublishJSON(event string) {
-	i.getWSClients(func(cc map[string]*websocket.Conn) {
+// PublishJSON sends event to all connected
+// websocket clients whose subscriptions accept it.
+func (i *Internal) PublishJSON(e event.Event) {
+	var failed []string
+	i.getWSClients(func(cc map[string]*wsClient) {
 		for id, c := range cc {
-			err := c.WriteJSON(fmt.Sprintf(`{"event":"%s"}`, event))
-			if err != nil {
+			if !c.accepts(e) {
+				continue
+			}
+
+			if err := c.write(e); err != nil {
 				if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
 					logrus.WithField("action", "websocket data writing").Error(err)
 				}
-				i.removeWSClient(id)
+				failed = append(failed, id)
 			}
 		}
 	})
+
+	// clients must be removed outside of
+	// the clients map lock.
+	for _, id := range failed {
+		i.removeWSClient(id)
+	}
 }
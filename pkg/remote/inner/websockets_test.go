@@ -0,0 +1,105 @@
+package inner
+
+import (
+	"eonbot/pkg/asset"
+	"eonbot/pkg/event"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWSClientAccepts(t *testing.T) {
+	pair := func(s string) asset.Pair {
+		p, err := asset.PairFromString(s)
+		assert.Nil(t, err)
+		return p
+	}
+
+	order := event.Event{Type: event.OrderFilled, Payload: event.OrderPayload{Pair: "ETH_BTC"}}
+	state := event.Event{Type: event.StateChange, Payload: event.StatePayload{State: "running"}}
+
+	tests := []struct {
+		Name     string
+		Requests []wsRequest
+		Event    event.Event
+		Result   bool
+	}{
+		{
+			Name:   "Event is accepted when there are no subscriptions",
+			Event:  order,
+			Result: true,
+		},
+		{
+			Name:     "Event is accepted when its type is subscribed",
+			Requests: []wsRequest{{Action: wsSubscribe, Events: []event.Type{event.OrderFilled}}},
+			Event:    order,
+			Result:   true,
+		},
+		{
+			Name:     "Event is not accepted when its type is not subscribed",
+			Requests: []wsRequest{{Action: wsSubscribe, Events: []event.Type{event.OrderPlaced}}},
+			Event:    order,
+			Result:   false,
+		},
+		{
+			Name:     "Event is accepted when its pair is subscribed",
+			Requests: []wsRequest{{Action: wsSubscribe, Pairs: []asset.Pair{pair("ETH_BTC")}}},
+			Event:    order,
+			Result:   true,
+		},
+		{
+			Name:     "Event is not accepted when its pair is not subscribed",
+			Requests: []wsRequest{{Action: wsSubscribe, Pairs: []asset.Pair{pair("DGB_BTC")}}},
+			Event:    order,
+			Result:   false,
+		},
+		{
+			Name:     "Not pair specific event is accepted when pairs are subscribed",
+			Requests: []wsRequest{{Action: wsSubscribe, Pairs: []asset.Pair{pair("DGB_BTC")}}},
+			Event:    state,
+			Result:   true,
+		},
+		{
+			Name: "Event is accepted when all subscriptions are removed",
+			Requests: []wsRequest{
+				{Action: wsSubscribe, Pairs: []asset.Pair{pair("DGB_BTC")}, Events: []event.Type{event.OrderPlaced}},
+				{Action: wsUnsubscribe, Pairs: []asset.Pair{pair("DGB_BTC")}, Events: []event.Type{event.OrderPlaced}},
+			},
+			Event:  order,
+			Result: true,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			c := newWSClient(nil)
+			for _, req := range v.Requests {
+				_, err := c.update(req)
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, v.Result, c.accepts(v.Event))
+		})
+	}
+}
+
+func TestWSClientUpdate(t *testing.T) {
+	c := newWSClient(nil)
+
+	_, err := c.update(wsRequest{Action: "test"})
+	assert.NotNil(t, err)
+
+	subs, err := c.update(wsRequest{Action: wsSubscribe, Events: []event.Type{event.OrderFilled}})
+	assert.Nil(t, err)
+	assert.Equal(t, wsSubscriptions{Pairs: []string{}, Events: []event.Type{event.OrderFilled}}, subs)
+
+	// request with unknown event type is rejected
+	// without changing subscriptions.
+	_, err = c.update(wsRequest{Action: wsSubscribe, Events: []event.Type{event.OrderPlaced, "order-filed"}})
+	assert.NotNil(t, err)
+
+	subs, err = c.update(wsRequest{Action: wsSubscribe})
+	assert.Nil(t, err)
+	assert.Equal(t, wsSubscriptions{Pairs: []string{}, Events: []event.Type{event.OrderFilled}}, subs)
+}
@@ -3,6 +3,7 @@ package inner
 import (
 	"encoding/json"
 	"eonbot/pkg/control"
+	"eonbot/pkg/settings"
 	"net/http"
 
 	"github.com/go-chi/chi"
@@ -11,9 +12,12 @@ import (
 func (i *Internal) workflowRoutes() http.Handler {
 	router := chi.NewRouter()
 	router.Get("/state", i.state)
-	router.Post("/start", i.start)
-	router.Post("/stop", i.stop)
-	router.Post("/restart", i.restart)
+	router.Group(func(r chi.Router) {
+		r.Use(requireScope(settings.InternalScopeTrading))
+		r.Post("/start", i.start)
+		r.Post("/stop", i.stop)
+		r.Post("/restart", i.restart)
+	})
 	return router
 }
 
@@ -4,24 +4,30 @@ import (
 	"eonbot/pkg/config"
 	"eonbot/pkg/control"
 	"eonbot/pkg/db"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
+	"eonbot/pkg/remote/email"
 	"eonbot/pkg/remote/inner"
 	"eonbot/pkg/remote/telegram"
+	"eonbot/pkg/remote/webhook"
 	"sync"
+
+	"github.com/sirupsen/logrus"
 )
 
 type Manager interface {
 	ConfigTelegram()
-	TelegramSend(msg string)
-	InternalSend(event string)
+	ConfigWebhooks()
+	ConfigEmail()
 	Stop()
 }
 
 type rc struct {
 	bot struct {
-		conf    config.Manager
-		control control.Controller
-		db      db.Manager
+		conf     config.Manager
+		control  control.Controller
+		db       db.Manager
+		exchange exchange.Exchange
 	}
 	conn struct {
 		teleMu   sync.RWMutex
@@ -29,16 +35,39 @@ type rc struct {
 
 		interMu  sync.RWMutex
 		internal *inner.Internal
+
+		hooksMu sync.Mutex
+		hooks   []hook
+
+		emailMu    sync.Mutex
+		email      *email.Email
+		emailUnsub func()
 	}
+
+	events *event.Bus
+
+	// unsubs specifies functions that remove
+	// event bus subscriptions.
+	unsubs []func()
 }
 
-func New(conf config.Manager, control control.Controller, db db.Manager, exchange exchange.Exchange) *rc {
+func New(conf config.Manager, control control.Controller, db db.Manager, exchange exchange.Exchange, events *event.Bus) *rc {
 	rc := &rc{}
 	rc.bot.conf = conf
 	rc.bot.control = control
 	rc.bot.db = db
+	rc.bot.exchange = exchange
+	rc.events = events
 	rc.conn.internal = inner.New(conf, control, db, exchange)
 	rc.ConfigTelegram()
+	rc.ConfigWebhooks()
+	rc.ConfigEmail()
+
+	rc.unsubs = append(rc.unsubs,
+		events.Subscribe(rc.internalSend, event.All),
+		events.Subscribe(rc.telegramSend, event.Types(telegram.NotifiableEvents...)),
+	)
+
 	return rc
 }
 
@@ -46,10 +75,10 @@ func (r *rc) ConfigTelegram() {
 	if r.bot.conf.RemoteConfig().Get().Telegram.Enable {
 		r.conn.teleMu.Lock()
 		if r.conn.telegram == nil {
-			r.conn.telegram = telegram.New(r.bot.conf, r.bot.control, r.bot.db)
+			r.conn.telegram = telegram.New(r.bot.conf, r.bot.control, r.bot.db, r.bot.exchange, r.events)
 		} else if r.conn.telegram.IsTokenModified() {
 			r.stopTelegram(false)
-			r.conn.telegram = telegram.New(r.bot.conf, r.bot.control, r.bot.db)
+			r.conn.telegram = telegram.New(r.bot.conf, r.bot.control, r.bot.db, r.bot.exchange, r.events)
 		}
 		r.conn.teleMu.Unlock()
 	} else {
@@ -58,29 +87,34 @@ func (r *rc) ConfigTelegram() {
 	}
 }
 
-func (r *rc) TelegramSend(msg string) {
+func (r *rc) telegramSend(e event.Event) {
 	r.conn.teleMu.RLock()
 	if r.conn.telegram == nil {
 		r.conn.teleMu.RUnlock()
 		return
 	}
 
-	r.conn.telegram.Publish(msg)
+	r.conn.telegram.Notify(e)
 	r.conn.teleMu.RUnlock()
 }
 
-func (r *rc) InternalSend(event string) {
+func (r *rc) internalSend(e event.Event) {
 	r.conn.interMu.RLock()
 	if r.conn.internal == nil {
 		r.conn.interMu.RUnlock()
 		return
 	}
 
-	r.conn.internal.PublishJSON(event)
+	r.conn.internal.PublishJSON(e)
 	r.conn.interMu.RUnlock()
 }
 
 func (r *rc) Stop() {
+	for _, unsub := range r.unsubs {
+		unsub()
+	}
+	r.stopWebhooks()
+	r.stopEmail()
 	r.stopTelegram(true)
 	r.stopInternal()
 }
@@ -106,3 +140,76 @@ func (r *rc) stopInternal() {
 	}
 	r.conn.interMu.Unlock()
 }
+
+// hook contains active webhook and
+// its event bus subscription.
+type hook struct {
+	webhook *webhook.Webhook
+	unsub   func()
+}
+
+// ConfigWebhooks replaces active webhooks with the
+// ones specified in the remote config.
+func (r *rc) ConfigWebhooks() {
+	r.stopWebhooks()
+
+	r.conn.hooksMu.Lock()
+	for _, conf := range r.bot.conf.RemoteConfig().Get().Webhooks {
+		w := webhook.New(conf)
+		unsub := r.events.Subscribe(func(e event.Event) {
+			if err := w.Handle(e); err != nil {
+				logrus.WithField("action", "webhook event sending").Error(err)
+			}
+		}, w.Accepts)
+
+		r.conn.hooks = append(r.conn.hooks, hook{webhook: w, unsub: unsub})
+	}
+	r.conn.hooksMu.Unlock()
+}
+
+func (r *rc) stopWebhooks() {
+	r.conn.hooksMu.Lock()
+	for _, h := range r.conn.hooks {
+		h.unsub()
+		h.webhook.Stop()
+	}
+	r.conn.hooks = nil
+	r.conn.hooksMu.Unlock()
+}
+
+// ConfigEmail replaces active email notifier with
+// the one specified in the remote config.
+func (r *rc) ConfigEmail() {
+	r.stopEmail()
+
+	conf := r.bot.conf.RemoteConfig().Get().Email
+	if !conf.Enable {
+		return
+	}
+
+	e, err := email.New(conf, r.bot.db)
+	if err != nil {
+		logrus.WithField("action", "email notifications configuration").Error(err)
+		return
+	}
+
+	r.conn.emailMu.Lock()
+	r.conn.email = e
+	r.conn.emailUnsub = r.events.Subscribe(func(ev event.Event) {
+		if err := e.Notify(ev); err != nil {
+			logrus.WithField("action", "email event sending").Error(err)
+		}
+	}, e.Accepts)
+	r.conn.emailMu.Unlock()
+}
+
+func (r *rc) stopEmail() {
+	r.conn.emailMu.Lock()
+	if r.conn.email != nil {
+		r.conn.emailUnsub()
+		r.conn.email.Stop()
+		r.conn.email = nil
+		r.conn.emailUnsub = nil
+	}
+	r.conn.emailMu.Unlock()
+}
@@ -0,0 +1,204 @@
+package telegram
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"eonbot/pkg/asset"
+	"eonbot/pkg/control"
+	"eonbot/pkg/event"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-telegram-bot-api/telegram-bot-api"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	actionSellAll   = "sell-all"
+	actionCancelAll = "cancel-all"
+	actionPause     = "pause-pair"
+	actionResume    = "resume-pair"
+	actionForceSell = "force-sell-pair"
+
+	// confirmationTTL specifies how long the action
+	// waits for user's confirmation.
+	confirmationTTL = time.Minute * 2
+
+	callbackConfirm = "confirm"
+	callbackCancel  = "cancel"
+)
+
+// action contains remote action which
+// requires user's confirmation.
+type action struct {
+	// Name specifies action's name.
+	Name string
+
+	// Pair specifies pair of pair specific
+	// actions.
+	Pair asset.Pair
+
+	// User specifies who requested the action.
+	User string
+
+	// Expires specifies when the confirmation
+	// expires.
+	Expires time.Time
+}
+
+// askConfirmation saves the action and sends the question
+// with confirm / cancel inline keyboard.
+func (t *Telegram) askConfirmation(a action, question string, chatID int64) {
+	id, err := t.addConfirmation(a)
+	if err != nil {
+		t.sendErrAndAbsorb("Confirmation preparation failed", err, chatID)
+		return
+	}
+
+	msg := tgbotapi.NewMessage(chatID, question)
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("Confirm", callbackConfirm+":"+id),
+		tgbotapi.NewInlineKeyboardButtonData("Cancel", callbackCancel+":"+id),
+	))
+
+	if _, err := t.conn.client.Send(msg); err != nil {
+		logrus.WithField("action", "telegram confirmation sending").Error(err)
+	}
+}
+
+// addConfirmation saves the action until it is confirmed,
+// cancelled or expires and returns its id.
+func (t *Telegram) addConfirmation(a action) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(b)
+
+	now := t.now()
+	a.Expires = now.Add(confirmationTTL)
+
+	t.conn.confMu.Lock()
+	for k, v := range t.conn.confirms {
+		if now.After(v.Expires) {
+			delete(t.conn.confirms, k)
+		}
+	}
+	t.conn.confirms[id] = a
+	t.conn.confMu.Unlock()
+
+	return id, nil
+}
+
+// errConfirmationExpired is returned when confirmed or cancelled
+// action does not exist or is expired.
+var errConfirmationExpired = errors.New("confirmation is expired or already used")
+
+// takeConfirmation removes the action and returns it.
+// Only the user who requested the action is allowed
+// to take it.
+func (t *Telegram) takeConfirmation(id, user string) (action, error) {
+	t.conn.confMu.Lock()
+	defer t.conn.confMu.Unlock()
+
+	a, ok := t.conn.confirms[id]
+	if !ok || t.now().After(a.Expires) {
+		delete(t.conn.confirms, id)
+		return action{}, errConfirmationExpired
+	}
+
+	if !strings.EqualFold(a.User, user) {
+		return action{}, fmt.Errorf("only %s can confirm or cancel this action", a.User)
+	}
+
+	delete(t.conn.confirms, id)
+	return a, nil
+}
+
+// parseCallback handles inline keyboard's
+// confirm / cancel buttons.
+func (t *Telegram) parseCallback(q *tgbotapi.CallbackQuery) {
+	user := username(q.From)
+	role, ok := t.userRole(user)
+	if !ok {
+		t.answerCallback(q.ID, "User not authorized.")
+		return
+	}
+
+	spl := strings.SplitN(q.Data, ":", 2)
+	if q.Message == nil || len(spl) != 2 {
+		t.answerCallback(q.ID, "")
+		return
+	}
+
+	var res string
+	a, err := t.takeConfirmation(spl[1], user)
+	switch {
+	case err == errConfirmationExpired:
+		res = "Confirmation is expired or already used."
+	case err != nil:
+		t.answerCallback(q.ID, err.Error())
+		return
+	case spl[0] != callbackConfirm:
+		res = "Action cancelled."
+	case !allows(role, actionRole(a.Name)):
+		res = fmt.Sprintf("Permission denied, '%s' role is required.", actionRole(a.Name))
+	default:
+		res = t.execAction(a)
+	}
+
+	t.answerCallback(q.ID, "")
+
+	edit := tgbotapi.NewEditMessageText(q.Message.Chat.ID, q.Message.MessageID, fmt.Sprintf("%s\n\n%s", q.Message.Text, res))
+	if _, err := t.conn.client.Send(edit); err != nil {
+		logrus.WithField("action", "telegram confirmation editing").Error(err)
+	}
+}
+
+func (t *Telegram) answerCallback(id, text string) {
+	if _, err := t.conn.client.AnswerCallbackQuery(tgbotapi.NewCallback(id, text)); err != nil {
+		logrus.WithField("action", "telegram callback answering").Error(err)
+	}
+}
+
+// execAction executes confirmed action, adds it to
+// the audit log and returns its result message.
+func (t *Telegram) execAction(a action) string {
+	var res string
+	switch a.Name {
+	case actionSellAll:
+		res = t.bot.control.Stop(control.StopInfo{Commons: control.Commons{SellAll: true}}, control.CauseRC).String()
+	case actionCancelAll:
+		res = t.bot.control.Stop(control.StopInfo{Commons: control.Commons{CancelAll: true}}, control.CauseRC).String()
+	case actionPause:
+		res = fmt.Sprintf("%s is already paused.", a.Pair)
+		if t.bot.control.PausePair(a.Pair) {
+			res = fmt.Sprintf("%s has been paused.", a.Pair)
+		}
+	case actionResume:
+		res = fmt.Sprintf("%s is not paused.", a.Pair)
+		if t.bot.control.ResumePair(a.Pair) {
+			res = fmt.Sprintf("%s has been resumed.", a.Pair)
+		}
+	case actionForceSell:
+		t.bot.control.ForceSellPair(a.Pair)
+		res = fmt.Sprintf("%s base asset will be sold during the next cycle.", a.Pair)
+	default:
+		return "Action not recognized."
+	}
+
+	payload := event.ActionPayload{
+		Action: a.Name,
+		Source: "telegram",
+		User:   a.User,
+		Result: res,
+	}
+	if a.Pair.IsValid() {
+		payload.Pair = a.Pair.String()
+	}
+	t.events.Publish(event.RemoteAction, payload)
+
+	return res
+}
@@ -0,0 +1,118 @@
+package telegram
+
+import (
+	"eonbot/pkg/asset"
+	"eonbot/pkg/control"
+	"eonbot/pkg/event"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTelegram() *Telegram {
+	t := &Telegram{}
+	t.bot.control = control.New(nil)
+	t.conn.confirms = make(map[string]action)
+	t.events = event.NewBus()
+	t.now = time.Now
+	return t
+}
+
+func TestConfirmations(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	tg := newTestTelegram()
+	tg.now = func() time.Time { return now }
+
+	pair := asset.NewPair("ETH", "BTC")
+	id, err := tg.addConfirmation(action{Name: actionPause, Pair: pair, User: "operator"})
+	assert.Nil(t, err)
+
+	// only the user who requested the action
+	// can confirm it.
+	_, err = tg.takeConfirmation(id, "viewer")
+	assert.EqualError(t, err, "only operator can confirm or cancel this action")
+
+	a, err := tg.takeConfirmation(id, "Operator")
+	assert.Nil(t, err)
+	assert.Equal(t, action{Name: actionPause, Pair: pair, User: "operator", Expires: now.Add(confirmationTTL)}, a)
+
+	// confirmation can be used only once.
+	_, err = tg.takeConfirmation(id, "operator")
+	assert.Equal(t, errConfirmationExpired, err)
+
+	_, err = tg.takeConfirmation("unknown", "operator")
+	assert.Equal(t, errConfirmationExpired, err)
+
+	id, err = tg.addConfirmation(action{Name: actionSellAll, User: "admin"})
+	assert.Nil(t, err)
+	now = now.Add(confirmationTTL + time.Second)
+	_, err = tg.takeConfirmation(id, "admin")
+	assert.Equal(t, errConfirmationExpired, err)
+
+	// expired confirmations are removed when
+	// new ones are added.
+	tg.conn.confirms["old"] = action{Expires: now.Add(-time.Second)}
+	_, err = tg.addConfirmation(action{Name: actionCancelAll})
+	assert.Nil(t, err)
+	assert.Len(t, tg.conn.confirms, 1)
+}
+
+func TestExecAction(t *testing.T) {
+	tg := newTestTelegram()
+	events := make(chan event.Event, 10)
+	tg.events.Subscribe(func(e event.Event) { events <- e }, event.Types(event.RemoteAction))
+
+	receive := func() event.Event {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(time.Second):
+			t.Fatal("event was not received")
+		}
+		return event.Event{}
+	}
+
+	pair := asset.NewPair("ETH", "BTC")
+
+	assert.Equal(t, "ETH_BTC has been paused.", tg.execAction(action{Name: actionPause, Pair: pair, User: "owner"}))
+	assert.True(t, tg.bot.control.IsPairPaused(pair))
+	assert.Equal(t, event.ActionPayload{
+		Action: actionPause,
+		Pair:   "ETH_BTC",
+		Source: "telegram",
+		User:   "owner",
+		Result: "ETH_BTC has been paused.",
+	}, receive().Payload)
+
+	assert.Equal(t, "ETH_BTC is already paused.", tg.execAction(action{Name: actionPause, Pair: pair}))
+	receive()
+
+	assert.Equal(t, "ETH_BTC has been resumed.", tg.execAction(action{Name: actionResume, Pair: pair}))
+	assert.False(t, tg.bot.control.IsPairPaused(pair))
+	receive()
+
+	assert.Equal(t, "ETH_BTC is not paused.", tg.execAction(action{Name: actionResume, Pair: pair}))
+	receive()
+
+	assert.Equal(t, "ETH_BTC base asset will be sold during the next cycle.", tg.execAction(action{Name: actionForceSell, Pair: pair}))
+	assert.True(t, tg.bot.control.TakeForceSell(pair))
+	assert.False(t, tg.bot.control.TakeForceSell(pair))
+	receive()
+
+	// bot is not running, so it cannot be stopped.
+	assert.Equal(t, "Bot is already stopped.", tg.execAction(action{Name: actionSellAll, User: "owner"}))
+	assert.Equal(t, event.ActionPayload{
+		Action: actionSellAll,
+		Source: "telegram",
+		User:   "owner",
+		Result: "Bot is already stopped.",
+	}, receive().Payload)
+
+	assert.Equal(t, "Action not recognized.", tg.execAction(action{Name: "unknown"}))
+	select {
+	case e := <-events:
+		t.Fatalf("unexpected %s event received", e.Type)
+	case <-time.After(time.Millisecond * 50):
+	}
+}
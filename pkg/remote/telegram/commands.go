@@ -1,9 +1,16 @@
 package telegram
 
 import (
+	"eonbot/pkg/asset"
 	"eonbot/pkg/control"
+	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/report"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"eonbot/pkg"
 
@@ -18,6 +25,19 @@ const (
 	cmdRestart = "restart"
 	cmdStatus  = "status"
 	cmdNotifs  = "notifs"
+
+	cmdBalances = "balances"
+	cmdOrders   = "orders"
+	cmdPnL      = "pnl"
+	cmdPairs    = "pairs"
+	cmdStrategy = "strategy"
+	cmdCooldown = "cooldown"
+
+	cmdSellAll   = "sellall"
+	cmdCancelAll = "cancelall"
+	cmdPause     = "pause"
+	cmdResume    = "resume"
+	cmdForceSell = "forcesell"
 )
 
 var helpList = map[string]string{
@@ -27,17 +47,42 @@ var helpList = map[string]string{
 	cmdStop:    fmt.Sprintf("/%s - Stops the bot.", cmdStop),
 	cmdRestart: fmt.Sprintf("/%s - Restarts the bot.", cmdRestart),
 	cmdStatus:  fmt.Sprintf("/%s - Shows current state of the bot.", cmdStatus),
-	cmdNotifs:  fmt.Sprintf("/%s - Toggles (enables/disables) all bot notifications in this chat.", cmdNotifs),
+	cmdNotifs:  fmt.Sprintf("/%s [list | <event> on|off] - Toggles (enables/disables) all bot notifications in this chat, shows or changes which events are sent.", cmdNotifs),
+
+	cmdBalances: fmt.Sprintf("/%s - Shows exchange balances.", cmdBalances),
+	cmdOrders:   fmt.Sprintf("/%s [pair] [days] - Shows the latest orders of all or the specified pair (default: last %d days).", cmdOrders, defaultOrdersDays),
+	cmdPnL:      fmt.Sprintf("/%s [period] - Shows realized PnL of the period, e.g. 12h, 7d or 2w (default: 24h).", cmdPnL),
+	cmdPairs:    fmt.Sprintf("/%s - Shows active pairs and their current mode.", cmdPairs),
+	cmdStrategy: fmt.Sprintf("/%s <pair> - Shows strategies snapshots of the pair's latest cycle.", cmdStrategy),
+	cmdCooldown: fmt.Sprintf("/%s - Shows exchange cooldown info.", cmdCooldown),
+
+	cmdSellAll:   fmt.Sprintf("/%s - Stops the bot and sells all base assets (requires confirmation).", cmdSellAll),
+	cmdCancelAll: fmt.Sprintf("/%s - Stops the bot and cancels all open orders (requires confirmation).", cmdCancelAll),
+	cmdPause:     fmt.Sprintf("/%s <pair> - Pauses pair's execution (requires confirmation).", cmdPause),
+	cmdResume:    fmt.Sprintf("/%s <pair> - Resumes paused pair's execution (requires confirmation).", cmdResume),
+	cmdForceSell: fmt.Sprintf("/%s <pair> - Sells pair's base asset during the next cycle (requires confirmation).", cmdForceSell),
 }
 
 func (t *Telegram) parseCMD(cmd *tgbotapi.Message) {
+	role, ok := t.userRole(username(cmd.From))
+	if !ok {
+		t.sendAndAbsorb("User not authorized.", cmd.Chat.ID)
+		return
+	}
+
 	if !cmd.IsCommand() {
 		t.sendAndAbsorb("Not a command.\nUse /help command to see possible commands list.", cmd.Chat.ID)
+		return
+	}
+
+	if required := commandRole(cmd.Command()); !allows(role, required) {
+		t.sendAndAbsorb(fmt.Sprintf("Permission denied, '%s' role is required.", required), cmd.Chat.ID)
+		return
 	}
 
 	switch cmd.Command() {
 	case cmdHelp:
-		t.helpCMD(cmd)
+		t.helpCMD(cmd, role)
 	case cmdVersion:
 		t.versionCMD(cmd)
 	case cmdStatus:
@@ -50,16 +95,46 @@ func (t *Telegram) parseCMD(cmd *tgbotapi.Message) {
 		t.restartCMD(cmd)
 	case cmdNotifs:
 		t.notifsCMD(cmd)
+	case cmdBalances:
+		t.balancesCMD(cmd)
+	case cmdOrders:
+		t.ordersCMD(cmd)
+	case cmdPnL:
+		t.pnlCMD(cmd)
+	case cmdPairs:
+		t.pairsCMD(cmd)
+	case cmdStrategy:
+		t.strategyCMD(cmd)
+	case cmdCooldown:
+		t.cooldownCMD(cmd)
+	case cmdSellAll:
+		t.askConfirmation(action{Name: actionSellAll, User: username(cmd.From)}, "Stop the bot and sell all base assets?", cmd.Chat.ID)
+	case cmdCancelAll:
+		t.askConfirmation(action{Name: actionCancelAll, User: username(cmd.From)}, "Stop the bot and cancel all open orders?", cmd.Chat.ID)
+	case cmdPause:
+		t.pairActionCMD(cmd, actionPause, "Pause %s execution?")
+	case cmdResume:
+		t.pairActionCMD(cmd, actionResume, "Resume %s execution?")
+	case cmdForceSell:
+		t.pairActionCMD(cmd, actionForceSell, "Sell %s base asset during the next cycle?")
 	default:
 		t.sendAndAbsorb("Command not recognized.\nUse /help command to see possible commands list.", cmd.Chat.ID)
 	}
 }
 
-func (t *Telegram) helpCMD(cmd *tgbotapi.Message) {
+func (t *Telegram) helpCMD(cmd *tgbotapi.Message, role string) {
+	cmds := make([]string, 0, len(helpList))
+	for c := range helpList {
+		if allows(role, commandRole(c)) {
+			cmds = append(cmds, c)
+		}
+	}
+	sort.Strings(cmds)
+
 	var str strings.Builder
 	str.WriteString("Commands list:\n")
-	for _, s := range helpList {
-		str.WriteString(s + "\n")
+	for _, c := range cmds {
+		str.WriteString(helpList[c] + "\n")
 	}
 
 	t.sendAndAbsorb(str.String(), cmd.Chat.ID)
@@ -89,14 +164,192 @@ func (t *Telegram) restartCMD(cmd *tgbotapi.Message) {
 }
 
 func (t *Telegram) notifsCMD(cmd *tgbotapi.Message) {
-	var msg string
-	if t.subscriberExists(cmd.Chat.ID) {
-		t.removeSubscriber(cmd.Chat.ID)
-		msg = "Notifications for this chat room have been disabled."
+	sub, subscribed := t.getSubscriber(cmd.Chat.ID)
+	if !subscribed {
+		sub = db.TelegramSubscriber{ChatID: cmd.Chat.ID, Username: username(cmd.From)}
+	}
+
+	args := strings.Fields(strings.ToLower(cmd.CommandArguments()))
+	switch {
+	case len(args) == 0:
+		var msg string
+		if subscribed {
+			t.removeSubscriber(cmd.Chat.ID)
+			msg = "Notifications for this chat room have been disabled."
+		} else {
+			t.setSubscriber(sub, true)
+			msg = "Notifications for this chat room have been enabled."
+		}
+
+		t.sendAndAbsorb(msg, cmd.Chat.ID)
+	case len(args) == 1 && args[0] == "list":
+		t.sendAndAbsorb(formatNotifs(sub, subscribed), cmd.Chat.ID)
+	case len(args) == 2 && (args[1] == "on" || args[1] == "off"):
+		e := event.Type(args[0])
+		if !isNotifiable(e) {
+			t.sendAndAbsorb(fmt.Sprintf("Event '%s' cannot be sent to telegram.\nUse /%s list command to see possible events list.", e, cmdNotifs), cmd.Chat.ID)
+			return
+		}
+
+		// the user who changes preferences
+		// becomes subscription's owner.
+		sub.Username = username(cmd.From)
+		sub = setNotif(sub, e, args[1] == "on")
+		t.setSubscriber(sub, true)
+		t.sendAndAbsorb(formatNotifs(sub, true), cmd.Chat.ID)
+	default:
+		t.sendAndAbsorb(fmt.Sprintf("Invalid arguments.\nUse /%s [list | <event> on|off].", cmdNotifs), cmd.Chat.ID)
+	}
+}
+
+func (t *Telegram) balancesCMD(cmd *tgbotapi.Message) {
+	balances, err := t.bot.exchange.GetBalances()
+	if err != nil {
+		t.sendErrAndAbsorb("Balances retrieval failed", err, cmd.Chat.ID)
+		return
+	}
+
+	t.sendAndAbsorb(formatBalances(balances), cmd.Chat.ID)
+}
+
+func (t *Telegram) ordersCMD(cmd *tgbotapi.Message) {
+	pair, days, err := parseOrdersArgs(cmd.CommandArguments())
+	if err != nil {
+		t.sendErrAndAbsorb("Invalid arguments", err, cmd.Chat.ID)
+		return
+	}
+
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -days)
+
+	var orders map[string][]exchange.BotOrder
+	if pair.IsValid() {
+		orders, err = t.bot.db.Persistent().GetPairOrders(pair, start, end)
 	} else {
-		t.setSubscriber(cmd.Chat.ID, true)
-		msg = "Notifications for this chat room have been enabled."
+		orders, err = t.bot.db.Persistent().GetOrders(start, end)
+	}
+	if err != nil && err != db.ErrDataNotFound {
+		t.sendErrAndAbsorb("Orders retrieval failed", err, cmd.Chat.ID)
+		return
+	}
+
+	t.sendAndAbsorb(formatOrders(orders, days), cmd.Chat.ID)
+}
+
+func (t *Telegram) pnlCMD(cmd *tgbotapi.Message) {
+	period, err := parsePeriod(cmd.CommandArguments())
+	if err != nil {
+		t.sendErrAndAbsorb("Invalid arguments", err, cmd.Chat.ID)
+		return
+	}
+
+	end := time.Now().UTC()
+	start := end.Add(-period)
+
+	orders, err := t.bot.db.Persistent().GetOrders(start.Add(-report.Lookback), end)
+	if err != nil && err != db.ErrDataNotFound {
+		t.sendErrAndAbsorb("Orders retrieval failed", err, cmd.Chat.ID)
+		return
 	}
 
-	t.sendAndAbsorb(msg, cmd.Chat.ID)
+	t.sendAndAbsorb(formatPnL(report.Summarize(orders, start, end), start, end), cmd.Chat.ID)
+}
+
+func (t *Telegram) pairsCMD(cmd *tgbotapi.Message) {
+	pairs := t.bot.conf.MainConfig().Get().BotConfig.ActivePairs
+	infos := make([]pairInfo, 0, len(pairs))
+	for _, pair := range pairs {
+		_, isMain := t.bot.conf.PairConfig(pair)
+		cyc, err := t.latestCycle(pair)
+		if err != nil {
+			t.sendErrAndAbsorb("Cycles retrieval failed", err, cmd.Chat.ID)
+			return
+		}
+
+		infos = append(infos, pairInfo{
+			Pair:      pair.String(),
+			SubConfig: !isMain,
+			Paused:    t.bot.control.IsPairPaused(pair),
+			Cycle:     cyc,
+		})
+	}
+
+	t.sendAndAbsorb(formatPairs(infos), cmd.Chat.ID)
+}
+
+func (t *Telegram) strategyCMD(cmd *tgbotapi.Message) {
+	pair, err := asset.PairFromString(cmd.CommandArguments())
+	if err != nil {
+		t.sendErrAndAbsorb("Invalid arguments", err, cmd.Chat.ID)
+		return
+	}
+
+	cyc, err := t.latestCycle(pair)
+	if err != nil {
+		t.sendErrAndAbsorb("Cycles retrieval failed", err, cmd.Chat.ID)
+		return
+	}
+
+	if cyc == nil {
+		t.sendAndAbsorb(fmt.Sprintf("No cycles of %s found.", pair), cmd.Chat.ID)
+		return
+	}
+
+	t.sendAndAbsorb(formatCycle(pair.String(), cyc), cmd.Chat.ID)
+}
+
+func (t *Telegram) cooldownCMD(cmd *tgbotapi.Message) {
+	info, err := t.bot.exchange.GetCooldownInfo()
+	if err != nil {
+		t.sendErrAndAbsorb("Cooldown info retrieval failed", err, cmd.Chat.ID)
+		return
+	}
+
+	t.sendAndAbsorb(formatCooldown(info, time.Now().UTC()), cmd.Chat.ID)
+}
+
+// pairActionCMD validates command's pair and asks
+// confirmation of the pair specific action.
+func (t *Telegram) pairActionCMD(cmd *tgbotapi.Message, name, question string) {
+	pair, err := asset.PairFromString(cmd.CommandArguments())
+	if err != nil {
+		t.sendErrAndAbsorb("Invalid arguments", err, cmd.Chat.ID)
+		return
+	}
+
+	if !t.isPairActive(pair) {
+		t.sendAndAbsorb(fmt.Sprintf("%s is not an active pair.", pair), cmd.Chat.ID)
+		return
+	}
+
+	t.askConfirmation(action{Name: name, Pair: pair, User: username(cmd.From)}, fmt.Sprintf(question, pair), cmd.Chat.ID)
+}
+
+func (t *Telegram) isPairActive(pair asset.Pair) bool {
+	for _, p := range t.bot.conf.MainConfig().Get().BotConfig.ActivePairs {
+		if p.Equal(pair) {
+			return true
+		}
+	}
+	return false
+}
+
+// latestCycle retrieves the latest pair's cycle from the db,
+// nil is returned if there are none.
+func (t *Telegram) latestCycle(pair asset.Pair) (*pkg.StreamCycle, error) {
+	cyc, err := t.bot.db.Persistent().GetPairCycle(pair, -1)
+	if err == db.ErrDataNotFound {
+		return nil, nil
+	}
+
+	return cyc, err
+}
+
+// username returns user's telegram username,
+// empty string if the user is not specified.
+func username(u *tgbotapi.User) string {
+	if u == nil {
+		return ""
+	}
+	return u.UserName
 }
@@ -4,8 +4,12 @@ import (
 	"eonbot/pkg/config"
 	"eonbot/pkg/control"
 	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+	"eonbot/pkg/exchange"
 	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-telegram-bot-api/telegram-bot-api"
 	"github.com/sirupsen/logrus"
@@ -13,20 +17,32 @@ import (
 
 type Telegram struct {
 	bot struct {
-		conf    config.Manager
-		control control.Controller
-		db      db.Manager
+		conf     config.Manager
+		control  control.Controller
+		db       db.Manager
+		exchange exchange.Exchange
 	}
 	conn struct {
 		token       string
 		client      *tgbotapi.BotAPI
 		stop        chan struct{}
 		subsMU      sync.RWMutex
-		subscribers map[int64]struct{}
+		subscribers map[int64]db.TelegramSubscriber
+
+		// confirms specifies actions waiting
+		// for user's confirmation.
+		confMu   sync.Mutex
+		confirms map[string]action
 	}
+
+	events *event.Bus
+
+	// now returns current time, it is replaced
+	// in tests.
+	now func() time.Time
 }
 
-func New(conf config.Manager, control control.Controller, db db.Manager) *Telegram {
+func New(conf config.Manager, control control.Controller, database db.Manager, exchange exchange.Exchange, events *event.Bus) *Telegram {
 	client, err := tgbotapi.NewBotAPI(conf.RemoteConfig().Get().Telegram.Token)
 	if err != nil {
 		logrus.WithField("action", "telegram bot init").Error(err)
@@ -36,11 +52,15 @@ func New(conf config.Manager, control control.Controller, db db.Manager) *Telegr
 	telegram := &Telegram{}
 	telegram.bot.conf = conf
 	telegram.bot.control = control
-	telegram.bot.db = db
+	telegram.bot.db = database
+	telegram.bot.exchange = exchange
 	telegram.conn.token = conf.RemoteConfig().Get().Telegram.Token
 	telegram.conn.client = client
 	telegram.conn.stop = make(chan struct{})
-	telegram.conn.subscribers = make(map[int64]struct{})
+	telegram.conn.subscribers = make(map[int64]db.TelegramSubscriber)
+	telegram.conn.confirms = make(map[string]action)
+	telegram.events = events
+	telegram.now = time.Now
 	telegram.prepSubs()
 
 	go func() {
@@ -61,6 +81,11 @@ func (t *Telegram) prepSubs() {
 	}
 
 	for _, sub := range subs {
+		// older versions allowed only the owner
+		// to subscribe.
+		if sub.Username == "" {
+			sub.Username = t.bot.conf.RemoteConfig().Get().Telegram.Owner
+		}
 		t.setSubscriber(sub, false)
 	}
 }
@@ -86,12 +111,13 @@ Outer:
 			t.cleanUp()
 			break Outer
 		case up := <-updates:
-			if up.Message == nil {
+			if up.CallbackQuery != nil {
+				t.parseCallback(up.CallbackQuery)
 				continue
 			}
 
-			if up.Message.From.UserName != t.bot.conf.RemoteConfig().Get().Telegram.Owner {
-				t.sendAndAbsorb("User not authorized.", up.Message.Chat.ID)
+			if up.Message == nil {
+				continue
 			}
 
 			t.parseCMD(up.Message)
@@ -122,19 +148,42 @@ func (t *Telegram) sendAndAbsorb(msg string, id int64) {
 	}
 }
 
+// sendErrAndAbsorb sends description of the failed
+// command with the error's message.
+func (t *Telegram) sendErrAndAbsorb(desc string, err error, id int64) {
+	msg := err.Error()
+	if e, ok := err.(exchange.Error); ok {
+		msg = e.Msg
+	}
+
+	t.sendAndAbsorb(fmt.Sprintf("%s: %s", desc, msg), id)
+}
+
 func (t *Telegram) IsTokenModified() bool {
 	return t.conn.token != t.bot.conf.RemoteConfig().Get().Telegram.Token
 }
 
-// Publish sends message to all subscribers.
+// Publish sends message to all authorized subscribers.
 func (t *Telegram) Publish(msg string) {
-	subs := t.getSubscribers()
-	if subs == nil || len(subs) <= 0 {
+	for _, sub := range t.getSubscribers() {
+		if _, ok := t.userRole(sub.Username); ok {
+			t.sendAndAbsorb(msg, sub.ChatID)
+		}
+	}
+}
+
+// Notify sends event's message to authorized
+// subscribers which have the event enabled.
+func (t *Telegram) Notify(e event.Event) {
+	msg, ok := formatEvent(e)
+	if !ok {
 		return
 	}
 
-	for id := range subs {
-		t.sendAndAbsorb(msg, id)
+	for _, sub := range t.getSubscribers() {
+		if _, ok := t.userRole(sub.Username); ok && isNotifEnabled(sub, e.Type) {
+			t.sendAndAbsorb(msg, sub.ChatID)
+		}
 	}
 }
 
@@ -146,12 +195,12 @@ func (t *Telegram) Stop() {
    subscriptions handling
 */
 
-func (t *Telegram) setSubscriber(id int64, save bool) {
+func (t *Telegram) setSubscriber(sub db.TelegramSubscriber, save bool) {
 	t.conn.subsMU.Lock()
-	t.conn.subscribers[id] = struct{}{}
+	t.conn.subscribers[sub.ChatID] = sub
 	t.conn.subsMU.Unlock()
 	if save {
-		if err := t.bot.db.Persistent().SaveTelegramSubscriber(id); err != nil {
+		if err := t.bot.db.Persistent().SaveTelegramSubscriber(sub); err != nil {
 			logrus.WithField("action", "telegram subscriber saving to db").Error(err)
 		}
 	}
@@ -166,22 +215,25 @@ func (t *Telegram) removeSubscriber(id int64) {
 	}
 }
 
-func (t *Telegram) subscriberExists(id int64) bool {
+func (t *Telegram) getSubscriber(id int64) (db.TelegramSubscriber, bool) {
 	t.conn.subsMU.RLock()
-	_, ok := t.conn.subscribers[id]
+	sub, ok := t.conn.subscribers[id]
 	t.conn.subsMU.RUnlock()
-	return ok
+	return sub, ok
 }
 
-func (t *Telegram) getSubscribers() (subs map[int64]struct{}) {
+func (t *Telegram) getSubscribers() []db.TelegramSubscriber {
 	t.conn.subsMU.RLock()
-	subs = t.conn.subscribers
+	subs := make([]db.TelegramSubscriber, 0, len(t.conn.subscribers))
+	for _, sub := range t.conn.subscribers {
+		subs = append(subs, sub)
+	}
 	t.conn.subsMU.RUnlock()
 	return subs
 }
 
 func (t *Telegram) cleanUp() {
 	t.conn.subsMU.Lock()
-	t.conn.subscribers = make(map[int64]struct{})
+	t.conn.subscribers = make(map[int64]db.TelegramSubscriber)
 	t.conn.subsMU.Unlock()
 }
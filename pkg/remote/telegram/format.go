@@ -0,0 +1,355 @@
+package telegram
+
+import (
+	"eonbot/pkg"
+	"eonbot/pkg/asset"
+	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/report"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	timeFormat = "2006-01-02 15:04:05 MST"
+
+	// ordersLimit specifies how many latest orders
+	// are shown in a single message.
+	ordersLimit = 20
+
+	defaultOrdersDays = 7
+	defaultPnLPeriod  = time.Hour * 24
+)
+
+// pairInfo contains active pair's data
+// shown by the pairs command.
+type pairInfo struct {
+	Pair string
+
+	// SubConfig specifies whether the pair uses
+	// sub config instead of the main config.
+	SubConfig bool
+
+	// Paused specifies whether the pair is
+	// paused by remote control.
+	Paused bool
+
+	// Cycle specifies the latest pair's cycle,
+	// nil if there are none.
+	Cycle *pkg.StreamCycle
+}
+
+// parseOrdersArgs parses orders command arguments: optional
+// pair and optional days count, in any order.
+func parseOrdersArgs(args string) (asset.Pair, int, error) {
+	var pair asset.Pair
+	days := defaultOrdersDays
+	for _, arg := range strings.Fields(args) {
+		if n, err := strconv.Atoi(arg); err == nil {
+			if n <= 0 {
+				return asset.Pair{}, 0, errors.New("days count must be positive")
+			}
+			days = n
+			continue
+		}
+
+		p, err := asset.PairFromString(arg)
+		if err != nil {
+			return asset.Pair{}, 0, err
+		}
+		pair = p
+	}
+
+	return pair, days, nil
+}
+
+// parsePeriod parses period of hours (e.g. 12h), days (e.g. 7d) or
+// weeks (e.g. 2w). Empty string returns default period.
+func parsePeriod(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return defaultPnLPeriod, nil
+	}
+
+	units := map[byte]time.Duration{
+		'h': time.Hour,
+		'd': time.Hour * 24,
+		'w': time.Hour * 24 * 7,
+	}
+
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, errors.New("period format is invalid, correct format: 12h, 7d or 2w")
+	}
+
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n <= 0 {
+		return 0, errors.New("period format is invalid, correct format: 12h, 7d or 2w")
+	}
+
+	return unit * time.Duration(n), nil
+}
+
+func formatBalances(balances map[string]decimal.Decimal) string {
+	assets := make([]string, 0, len(balances))
+	for a, b := range balances {
+		if !b.IsZero() {
+			assets = append(assets, a)
+		}
+	}
+
+	if len(assets) == 0 {
+		return "No balances."
+	}
+
+	sort.Strings(assets)
+
+	var str strings.Builder
+	str.WriteString("Balances:\n")
+	for _, a := range assets {
+		fmt.Fprintf(&str, "%s: %s\n", a, balances[a])
+	}
+
+	return str.String()
+}
+
+// formatOrders formats the latest orders (up to ordersLimit),
+// newest first.
+func formatOrders(orders map[string][]exchange.BotOrder, days int) string {
+	type pairOrder struct {
+		pair string
+		exchange.BotOrder
+	}
+
+	all := make([]pairOrder, 0)
+	for pair, oo := range orders {
+		for _, o := range oo {
+			all = append(all, pairOrder{pair, o})
+		}
+	}
+
+	if len(all) == 0 {
+		return fmt.Sprintf("No orders during the last %d day(s).", days)
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Timestamp.After(all[j].Timestamp)
+	})
+
+	var str strings.Builder
+	fmt.Fprintf(&str, "Orders during the last %d day(s): %d\n", days, len(all))
+	if len(all) > ordersLimit {
+		fmt.Fprintf(&str, "Showing the latest %d:\n", ordersLimit)
+		all = all[:ordersLimit]
+	}
+
+	for _, o := range all {
+		fmt.Fprintf(&str, "\n%s\n%s %s %s @ %s", o.Timestamp.UTC().Format(timeFormat), o.pair, o.Side, o.Amount, o.Rate)
+		if o.Strategy != "" {
+			fmt.Fprintf(&str, " (%s)", o.Strategy)
+		}
+		str.WriteString("\n")
+	}
+
+	return str.String()
+}
+
+func formatPnL(sums []report.PairSummary, start, end time.Time) string {
+	var str strings.Builder
+	fmt.Fprintf(&str, "PnL from %s to %s:\n", start.UTC().Format(timeFormat), end.UTC().Format(timeFormat))
+
+	if len(sums) == 0 {
+		str.WriteString("\nNo orders were filled.")
+		return str.String()
+	}
+
+	totals := make(map[string]decimal.Decimal)
+	for _, sum := range sums {
+		fmt.Fprintf(&str, "\n%s:\nBuy orders: %d (total: %s)\nSell orders: %d (total: %s)\nRealized PnL: %s\n",
+			sum.Pair, sum.Buys, sum.Bought, sum.Sells, sum.Sold, sum.PnL)
+
+		if pair, err := asset.PairFromString(sum.Pair); err == nil {
+			counter := string(pair.Counter)
+			totals[counter] = totals[counter].Add(sum.PnL)
+		}
+	}
+
+	counters := make([]string, 0, len(totals))
+	for c := range totals {
+		counters = append(counters, c)
+	}
+	sort.Strings(counters)
+
+	str.WriteString("\nTotal realized PnL:\n")
+	for _, c := range counters {
+		fmt.Fprintf(&str, "%s: %s\n", c, totals[c])
+	}
+
+	return str.String()
+}
+
+func formatPairs(pairs []pairInfo) string {
+	if len(pairs) == 0 {
+		return "No active pairs."
+	}
+
+	var str strings.Builder
+	str.WriteString("Active pairs:\n")
+	for _, p := range pairs {
+		conf := "main config"
+		if p.SubConfig {
+			conf = "sub config"
+		}
+
+		mode := cycleMode(p.Cycle)
+		if p.Paused {
+			mode = "paused by remote control"
+		}
+		fmt.Fprintf(&str, "\n%s (%s)\nMode: %s\n", p.Pair, conf, mode)
+	}
+
+	return str.String()
+}
+
+// cycleMode describes what the pair's stream
+// was doing during the cycle.
+func cycleMode(cyc *pkg.StreamCycle) string {
+	if cyc == nil {
+		return "no cycles yet"
+	}
+
+	if !cyc.IsSuccessful {
+		return "failed: " + cyc.Error
+	}
+
+	switch res := cyc.Result.(type) {
+	case *pkg.StrategiesResult:
+		return "executing strategies"
+	case *pkg.OpenOrdersResult:
+		return fmt.Sprintf("waiting for open orders (%d open)", res.Open)
+	case *pkg.SuspendedResult:
+		return "suspended: " + res.Reason
+	default:
+		return "unknown"
+	}
+}
+
+func formatCycle(pair string, cyc *pkg.StreamCycle) string {
+	var str strings.Builder
+	fmt.Fprintf(&str, "%s latest cycle (%s):\n", pair, cyc.CompletedAt.UTC().Format(timeFormat))
+
+	res, ok := cyc.Result.(*pkg.StrategiesResult)
+	if !ok {
+		fmt.Fprintf(&str, "Mode: %s\n", cycleMode(cyc))
+		return str.String()
+	}
+
+	names := make([]string, 0, len(res.Snapshots))
+	for name := range res.Snapshots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		snap := res.Snapshots[name]
+		fmt.Fprintf(&str, "\n%s: %s\nSequence: %s\n", name, condsMet(snap.CondsMet), snap.Seq)
+
+		tools := make([]string, 0, len(snap.Tools))
+		for tool := range snap.Tools {
+			tools = append(tools, tool)
+		}
+		sort.Strings(tools)
+
+		for _, tool := range tools {
+			full := snap.Tools[tool]
+			fmt.Fprintf(&str, "- %s (%s): %s\n", tool, full.Type, condsMet(full.Snapshot.CondsMet))
+		}
+	}
+
+	if len(res.Throttled) > 0 {
+		fmt.Fprintf(&str, "\nThrottled: %s\n", strings.Join(res.Throttled, ", "))
+	}
+
+	return str.String()
+}
+
+func condsMet(met bool) string {
+	if met {
+		return "conditions met"
+	}
+	return "conditions not met"
+}
+
+func formatCooldown(info exchange.CooldownInfo, now time.Time) string {
+	if !info.Active || !info.End.After(now) {
+		return "Exchange cooldown is not active."
+	}
+
+	return fmt.Sprintf("Exchange cooldown is active.\nStarted: %s\nEnds: %s (in %s)",
+		info.Start.UTC().Format(timeFormat), info.End.UTC().Format(timeFormat), info.End.Sub(now).Round(time.Second))
+}
+
+// formatEvent prepares event's notification message.
+// False is returned if the event should not be sent.
+func formatEvent(e event.Event) (string, bool) {
+	switch p := e.Payload.(type) {
+	case event.StatePayload:
+		return p.State, true
+	case event.MessagePayload:
+		return p.Msg, true
+	case event.OrderPayload:
+		if e.Type != event.OrderFilled {
+			return "", false
+		}
+
+		msg := fmt.Sprintf("%s %s order filled: %s @ %s", p.Pair, p.Side, p.Amount, p.Rate)
+		if p.Strategy != "" {
+			msg += fmt.Sprintf(" (%s)", p.Strategy)
+		}
+		return msg, true
+	case event.CyclePayload:
+		if e.Type != event.PairCycleFailed || p.Cycle == nil {
+			return "", false
+		}
+		return fmt.Sprintf("%s cycle failed: %s", p.Pair, p.Cycle.Error), true
+	case event.ActionPayload:
+		action := p.Action
+		if p.Pair != "" {
+			action += " " + p.Pair
+		}
+		return fmt.Sprintf("%s executed '%s' via %s.\n%s", p.User, action, p.Source, p.Result), true
+	}
+
+	if e.Type == event.CooldownActivation {
+		return "Exchange cooldown has been activated.", true
+	}
+
+	return "", false
+}
+
+func formatNotifs(sub db.TelegramSubscriber, subscribed bool) string {
+	var str strings.Builder
+	if subscribed {
+		str.WriteString("Notifications for this chat room are enabled.\n")
+	} else {
+		str.WriteString("Notifications for this chat room are disabled.\n")
+	}
+
+	str.WriteString("\nEvents:\n")
+	for _, e := range NotifiableEvents {
+		state := "off"
+		if isNotifEnabled(sub, e) {
+			state = "on"
+		}
+		fmt.Fprintf(&str, "%s: %s\n", e, state)
+	}
+
+	return str.String()
+}
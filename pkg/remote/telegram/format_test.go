@@ -0,0 +1,328 @@
+package telegram
+
+import (
+	"eonbot/pkg"
+	"eonbot/pkg/asset"
+	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/report"
+	"eonbot/pkg/strategy"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrdersArgs(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Args   string
+		Pair   asset.Pair
+		Days   int
+		Errors bool
+	}{
+		{
+			Name: "Empty args",
+			Days: defaultOrdersDays,
+		},
+		{
+			Name: "Pair only",
+			Args: "eth_btc",
+			Pair: asset.NewPair("ETH", "BTC"),
+			Days: defaultOrdersDays,
+		},
+		{
+			Name: "Days only",
+			Args: "30",
+			Days: 30,
+		},
+		{
+			Name: "Pair and days",
+			Args: "3 ETH_BTC",
+			Pair: asset.NewPair("ETH", "BTC"),
+			Days: 3,
+		},
+		{
+			Name:   "Invalid days",
+			Args:   "ETH_BTC 0",
+			Errors: true,
+		},
+		{
+			Name:   "Invalid pair",
+			Args:   "ETHBTC",
+			Errors: true,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			pair, days, err := parseOrdersArgs(v.Args)
+			if v.Errors {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Pair, pair)
+			assert.Equal(t, v.Days, days)
+		})
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Period string
+		Result time.Duration
+		Errors bool
+	}{
+		{
+			Name:   "Empty period",
+			Result: defaultPnLPeriod,
+		},
+		{
+			Name:   "Hours",
+			Period: "12h",
+			Result: time.Hour * 12,
+		},
+		{
+			Name:   "Days",
+			Period: "7D",
+			Result: time.Hour * 24 * 7,
+		},
+		{
+			Name:   "Weeks",
+			Period: "2w",
+			Result: time.Hour * 24 * 14,
+		},
+		{
+			Name:   "Invalid unit",
+			Period: "3m",
+			Errors: true,
+		},
+		{
+			Name:   "Invalid number",
+			Period: "-1d",
+			Errors: true,
+		},
+		{
+			Name:   "Unit only",
+			Period: "d",
+			Errors: true,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := parsePeriod(v.Period)
+			if v.Errors {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Result, res)
+		})
+	}
+}
+
+func TestFormatBalances(t *testing.T) {
+	assert.Equal(t, "No balances.", formatBalances(nil))
+	assert.Equal(t, "Balances:\nBTC: 0.5\nETH: 12\n", formatBalances(map[string]decimal.Decimal{
+		"ETH": decimal.New(12, 0),
+		"DGB": decimal.Zero,
+		"BTC": decimal.New(5, -1),
+	}))
+}
+
+func TestFormatOrders(t *testing.T) {
+	assert.Equal(t, "No orders during the last 7 day(s).", formatOrders(nil, 7))
+
+	ts := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+	orders := map[string][]exchange.BotOrder{
+		"ETH_BTC": {
+			exchange.NewBotOrder(exchange.Order{Timestamp: ts, Side: "buy", Amount: decimal.New(2, 0), Rate: decimal.New(1, -2)}, "macd"),
+		},
+		"DGB_BTC": {
+			exchange.NewBotOrder(exchange.Order{Timestamp: ts.Add(time.Hour), Side: "sell", Amount: decimal.New(100, 0), Rate: decimal.New(1, -6)}, ""),
+		},
+	}
+
+	assert.Equal(t, "Orders during the last 1 day(s): 2\n"+
+		"\n2020-01-02 16:04:05 UTC\nDGB_BTC sell 100 @ 0.000001\n"+
+		"\n2020-01-02 15:04:05 UTC\nETH_BTC buy 2 @ 0.01 (macd)\n", formatOrders(orders, 1))
+
+	many := make([]exchange.BotOrder, ordersLimit+5)
+	for i := range many {
+		many[i] = exchange.NewBotOrder(exchange.Order{Timestamp: ts.Add(time.Minute * time.Duration(i))}, "")
+	}
+	res := formatOrders(map[string][]exchange.BotOrder{"ETH_BTC": many}, 1)
+	assert.Contains(t, res, "Orders during the last 1 day(s): 25\nShowing the latest 20:\n")
+	assert.Contains(t, res, "2020-01-02 15:28:05 UTC")
+	assert.NotContains(t, res, "2020-01-02 15:04:05 UTC")
+}
+
+func TestFormatPnL(t *testing.T) {
+	start := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 24)
+
+	assert.Equal(t, "PnL from 2020-01-02 00:00:00 UTC to 2020-01-03 00:00:00 UTC:\n\nNo orders were filled.", formatPnL(nil, start, end))
+
+	res := formatPnL([]report.PairSummary{
+		{Pair: "DGB_BTC", Sells: 1, Sold: decimal.New(4, 0), PnL: decimal.New(-1, 0)},
+		{Pair: "ETH_BTC", Buys: 1, Sells: 1, Bought: decimal.New(32, 0), Sold: decimal.New(45, 0), PnL: decimal.New(6, 0)},
+		{Pair: "ETH_USDT", Sells: 1, Sold: decimal.New(200, 0), PnL: decimal.New(20, 0)},
+	}, start, end)
+	assert.Contains(t, res, "\nETH_BTC:\nBuy orders: 1 (total: 32)\nSell orders: 1 (total: 45)\nRealized PnL: 6\n")
+	assert.Contains(t, res, "\nTotal realized PnL:\nBTC: 5\nUSDT: 20\n")
+}
+
+func TestFormatPairs(t *testing.T) {
+	assert.Equal(t, "No active pairs.", formatPairs(nil))
+	assert.Equal(t, "Active pairs:\n"+
+		"\nETH_BTC (main config)\nMode: executing strategies\n"+
+		"\nDGB_BTC (sub config)\nMode: suspended: outside of trading schedule\n"+
+		"\nLTC_BTC (main config)\nMode: no cycles yet\n"+
+		"\nXRP_BTC (main config)\nMode: paused by remote control\n", formatPairs([]pairInfo{
+		{Pair: "ETH_BTC", Cycle: pkg.NewStreamCycle(time.Time{}, time.Time{}, pkg.NewSrategiesResult(nil), nil)},
+		{Pair: "DGB_BTC", SubConfig: true, Cycle: pkg.NewStreamCycle(time.Time{}, time.Time{}, pkg.NewSuspendedResult("outside of trading schedule"), nil)},
+		{Pair: "LTC_BTC"},
+		{Pair: "XRP_BTC", Paused: true, Cycle: pkg.NewStreamCycle(time.Time{}, time.Time{}, pkg.NewSrategiesResult(nil), nil)},
+	}))
+}
+
+func TestCycleMode(t *testing.T) {
+	assert.Equal(t, "no cycles yet", cycleMode(nil))
+	assert.Equal(t, "failed: timeout", cycleMode(pkg.NewStreamCycle(time.Time{}, time.Time{}, nil, errors.New("timeout"))))
+	assert.Equal(t, "waiting for open orders (2 open)", cycleMode(pkg.NewStreamCycle(time.Time{}, time.Time{}, pkg.NewOpenOrdersResult(3, 2, 1), nil)))
+}
+
+func TestFormatCycle(t *testing.T) {
+	ts := time.Date(2020, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	res := pkg.NewSrategiesResult(map[string]strategy.Snapshot{
+		"macd": {
+			CondsMet: true,
+			Seq:      "macd1 && rsi1",
+			Tools: map[string]tools.FullSnapshot{
+				"rsi1":  {Type: "rsi", Snapshot: tools.Snapshot{CondsMet: true}},
+				"macd1": {Type: "macd", Snapshot: tools.Snapshot{CondsMet: true}},
+			},
+		},
+		"bb": {
+			Seq: "bb1",
+			Tools: map[string]tools.FullSnapshot{
+				"bb1": {Type: "bb"},
+			},
+		},
+	})
+	res.Throttled = []string{"bb"}
+
+	assert.Equal(t, "ETH_BTC latest cycle (2020-01-02 15:04:05 UTC):\n"+
+		"\nbb: conditions not met\nSequence: bb1\n- bb1 (bb): conditions not met\n"+
+		"\nmacd: conditions met\nSequence: macd1 && rsi1\n- macd1 (macd): conditions met\n- rsi1 (rsi): conditions met\n"+
+		"\nThrottled: bb\n", formatCycle("ETH_BTC", pkg.NewStreamCycle(ts, ts, res, nil)))
+
+	assert.Equal(t, "ETH_BTC latest cycle (2020-01-02 15:04:05 UTC):\nMode: failed: timeout\n",
+		formatCycle("ETH_BTC", pkg.NewStreamCycle(ts, ts, nil, errors.New("timeout"))))
+}
+
+func TestFormatCooldown(t *testing.T) {
+	now := time.Date(2020, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "Exchange cooldown is not active.", formatCooldown(exchange.CooldownInfo{}, now))
+	assert.Equal(t, "Exchange cooldown is not active.", formatCooldown(exchange.CooldownInfo{Active: true, End: now}, now))
+	assert.Equal(t, "Exchange cooldown is active.\nStarted: 2020-01-02 14:50:00 UTC\nEnds: 2020-01-02 15:05:30 UTC (in 5m30s)",
+		formatCooldown(exchange.CooldownInfo{Active: true, Start: now.Add(-time.Minute * 10), End: now.Add(time.Second * 330)}, now))
+}
+
+func TestFormatEvent(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Event  event.Event
+		Result string
+		Sent   bool
+	}{
+		{
+			Name:   "State change",
+			Event:  event.Event{Type: event.StateChange, Payload: event.StatePayload{State: "Bot is running."}},
+			Result: "Bot is running.",
+			Sent:   true,
+		},
+		{
+			Name:   "Outcome message",
+			Event:  event.Event{Type: event.OutcomeMessage, Payload: event.MessagePayload{Msg: "to the moon!"}},
+			Result: "to the moon!",
+			Sent:   true,
+		},
+		{
+			Name: "Order filled",
+			Event: event.Event{Type: event.OrderFilled, Payload: event.OrderPayload{
+				Pair: "ETH_BTC", Side: "buy", Amount: decimal.New(2, 0), Rate: decimal.New(1, -2), Strategy: "macd",
+			}},
+			Result: "ETH_BTC buy order filled: 2 @ 0.01 (macd)",
+			Sent:   true,
+		},
+		{
+			Name:  "Order placed",
+			Event: event.Event{Type: event.OrderPlaced, Payload: event.OrderPayload{Pair: "ETH_BTC"}},
+		},
+		{
+			Name: "Pair cycle failed",
+			Event: event.Event{Type: event.PairCycleFailed, Payload: event.CyclePayload{
+				Pair: "ETH_BTC", Cycle: pkg.NewStreamCycle(time.Time{}, time.Time{}, nil, errors.New("timeout")),
+			}},
+			Result: "ETH_BTC cycle failed: timeout",
+			Sent:   true,
+		},
+		{
+			Name:  "Pair cycle completed",
+			Event: event.Event{Type: event.PairCycleCompleted, Payload: event.CyclePayload{Pair: "ETH_BTC"}},
+		},
+		{
+			Name:   "Cooldown activation",
+			Event:  event.Event{Type: event.CooldownActivation},
+			Result: "Exchange cooldown has been activated.",
+			Sent:   true,
+		},
+		{
+			Name: "Remote action",
+			Event: event.Event{Type: event.RemoteAction, Payload: event.ActionPayload{
+				Action: actionPause, Pair: "ETH_BTC", Source: "telegram", User: "operator", Result: "ETH_BTC has been paused.",
+			}},
+			Result: "operator executed 'pause-pair ETH_BTC' via telegram.\nETH_BTC has been paused.",
+			Sent:   true,
+		},
+		{
+			Name:  "Cycle end",
+			Event: event.Event{Type: event.CycleEnd},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, sent := formatEvent(v.Event)
+			assert.Equal(t, v.Sent, sent)
+			assert.Equal(t, v.Result, res)
+		})
+	}
+}
+
+func TestFormatNotifs(t *testing.T) {
+	sub := db.TelegramSubscriber{Events: []event.Type{event.OrderFilled}}
+	assert.Equal(t, "Notifications for this chat room are enabled.\n"+
+		"\nEvents:\nstate-update: off\noutcome-message: off\norder-filled: on\n"+
+		"cooldown-activation: off\npair-cycle-failed: off\nremote-action: off\n", formatNotifs(sub, true))
+
+	assert.Contains(t, formatNotifs(db.TelegramSubscriber{}, false), "are disabled.\n\nEvents:\nstate-update: on\noutcome-message: on\n")
+}
@@ -0,0 +1,74 @@
+package telegram
+
+import (
+	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+)
+
+// NotifiableEvents specifies events which
+// can be sent to telegram subscribers.
+var NotifiableEvents = []event.Type{
+	event.StateChange,
+	event.OutcomeMessage,
+	event.OrderFilled,
+	event.CooldownActivation,
+	event.PairCycleFailed,
+	event.RemoteAction,
+}
+
+// defaultNotifs specifies events sent to subscribers
+// which haven't changed their preferences.
+var defaultNotifs = []event.Type{event.StateChange, event.OutcomeMessage}
+
+// isNotifiable checks whether the event type
+// can be sent to telegram subscribers.
+func isNotifiable(t event.Type) bool {
+	for _, n := range NotifiableEvents {
+		if n == t {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotifEnabled checks whether the subscriber
+// should be notified about the event.
+func isNotifEnabled(sub db.TelegramSubscriber, t event.Type) bool {
+	events := sub.Events
+	if events == nil {
+		events = defaultNotifs
+	}
+
+	for _, e := range events {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// setNotif enables or disables subscriber's
+// event notifications.
+func setNotif(sub db.TelegramSubscriber, t event.Type, enable bool) db.TelegramSubscriber {
+	current := sub.Events
+	if current == nil {
+		current = defaultNotifs
+	}
+
+	events := make([]event.Type, 0, len(NotifiableEvents))
+	for _, n := range NotifiableEvents {
+		if n == t {
+			if enable {
+				events = append(events, n)
+			}
+			continue
+		}
+
+		if isNotifEnabled(db.TelegramSubscriber{Events: current}, n) {
+			events = append(events, n)
+		}
+	}
+
+	sub.Events = events
+	return sub
+}
@@ -0,0 +1,30 @@
+package telegram
+
+import (
+	"eonbot/pkg/db"
+	"eonbot/pkg/event"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifs(t *testing.T) {
+	sub := db.TelegramSubscriber{ChatID: 1, Username: "user"}
+	assert.True(t, isNotifEnabled(sub, event.StateChange))
+	assert.True(t, isNotifEnabled(sub, event.OutcomeMessage))
+	assert.False(t, isNotifEnabled(sub, event.OrderFilled))
+
+	sub = setNotif(sub, event.OrderFilled, true)
+	assert.Equal(t, []event.Type{event.StateChange, event.OutcomeMessage, event.OrderFilled}, sub.Events)
+
+	sub = setNotif(sub, event.StateChange, false)
+	assert.Equal(t, []event.Type{event.OutcomeMessage, event.OrderFilled}, sub.Events)
+
+	sub = setNotif(sub, event.OutcomeMessage, false)
+	sub = setNotif(sub, event.OrderFilled, false)
+	assert.Equal(t, []event.Type{}, sub.Events)
+	assert.False(t, isNotifEnabled(sub, event.StateChange))
+
+	assert.True(t, isNotifiable(event.RemoteAction))
+	assert.False(t, isNotifiable(event.CycleEnd))
+}
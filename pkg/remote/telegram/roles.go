@@ -0,0 +1,86 @@
+package telegram
+
+import "eonbot/pkg/settings"
+
+// roleLevels specifies roles' privilege levels, higher level
+// role is allowed to do everything lower level roles can.
+var roleLevels = map[string]int{
+	settings.TelegramRoleViewer:   1,
+	settings.TelegramRoleOperator: 2,
+	settings.TelegramRoleAdmin:    3,
+}
+
+// commandRoles specifies the lowest role allowed to
+// execute the command. Commands not specified here
+// require admin role.
+var commandRoles = map[string]string{
+	// viewer can only see bot's status
+	// and manage notifications.
+	cmdHelp:    settings.TelegramRoleViewer,
+	cmdVersion: settings.TelegramRoleViewer,
+	cmdStatus:  settings.TelegramRoleViewer,
+	cmdNotifs:  settings.TelegramRoleViewer,
+
+	cmdStart:    settings.TelegramRoleOperator,
+	cmdStop:     settings.TelegramRoleOperator,
+	cmdRestart:  settings.TelegramRoleOperator,
+	cmdPause:    settings.TelegramRoleOperator,
+	cmdResume:   settings.TelegramRoleOperator,
+	cmdBalances: settings.TelegramRoleOperator,
+	cmdOrders:   settings.TelegramRoleOperator,
+	cmdPnL:      settings.TelegramRoleOperator,
+	cmdPairs:    settings.TelegramRoleOperator,
+	cmdStrategy: settings.TelegramRoleOperator,
+	cmdCooldown: settings.TelegramRoleOperator,
+
+	cmdSellAll:   settings.TelegramRoleAdmin,
+	cmdCancelAll: settings.TelegramRoleAdmin,
+	cmdForceSell: settings.TelegramRoleAdmin,
+}
+
+// actionRoles specifies the lowest role allowed to
+// confirm the action. Actions not specified here
+// require admin role.
+var actionRoles = map[string]string{
+	actionPause:     settings.TelegramRoleOperator,
+	actionResume:    settings.TelegramRoleOperator,
+	actionSellAll:   settings.TelegramRoleAdmin,
+	actionCancelAll: settings.TelegramRoleAdmin,
+	actionForceSell: settings.TelegramRoleAdmin,
+}
+
+// allows checks whether the role is
+// allowed to do what required role can.
+func allows(role, required string) bool {
+	if required == "" {
+		required = settings.TelegramRoleViewer
+	}
+
+	level, ok := roleLevels[role]
+	return ok && level >= roleLevels[required]
+}
+
+// commandRole returns the lowest role
+// allowed to execute the command.
+func commandRole(cmd string) string {
+	if role, ok := commandRoles[cmd]; ok {
+		return role
+	}
+	return settings.TelegramRoleAdmin
+}
+
+// actionRole returns the lowest role
+// allowed to confirm the action.
+func actionRole(name string) string {
+	if role, ok := actionRoles[name]; ok {
+		return role
+	}
+	return settings.TelegramRoleAdmin
+}
+
+// userRole returns user's role from the current
+// remote config. False is returned if the user
+// is not authorized.
+func (t *Telegram) userRole(username string) (string, bool) {
+	return t.bot.conf.RemoteConfig().Get().Telegram.UserRole(username)
+}
@@ -0,0 +1,94 @@
+package telegram
+
+import (
+	"eonbot/pkg/settings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Role     string
+		Required string
+		Result   bool
+	}{
+		{
+			Name:     "Viewer as viewer",
+			Role:     settings.TelegramRoleViewer,
+			Required: settings.TelegramRoleViewer,
+			Result:   true,
+		},
+		{
+			Name:     "Viewer as operator",
+			Role:     settings.TelegramRoleViewer,
+			Required: settings.TelegramRoleOperator,
+			Result:   false,
+		},
+		{
+			Name:     "Operator as viewer",
+			Role:     settings.TelegramRoleOperator,
+			Required: settings.TelegramRoleViewer,
+			Result:   true,
+		},
+		{
+			Name:     "Operator as admin",
+			Role:     settings.TelegramRoleOperator,
+			Required: settings.TelegramRoleAdmin,
+			Result:   false,
+		},
+		{
+			Name:     "Admin as admin",
+			Role:     settings.TelegramRoleAdmin,
+			Required: settings.TelegramRoleAdmin,
+			Result:   true,
+		},
+		{
+			Name:     "Viewer as unspecified",
+			Role:     settings.TelegramRoleViewer,
+			Required: "",
+			Result:   true,
+		},
+		{
+			Name:     "Unknown role",
+			Role:     "guest",
+			Required: settings.TelegramRoleViewer,
+			Result:   false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, v.Result, allows(v.Role, v.Required))
+		})
+	}
+}
+
+func TestCommandRole(t *testing.T) {
+	assert.Equal(t, settings.TelegramRoleViewer, commandRole(cmdStatus))
+	assert.Equal(t, settings.TelegramRoleViewer, commandRole(cmdNotifs))
+	assert.Equal(t, settings.TelegramRoleOperator, commandRole(cmdStop))
+	assert.Equal(t, settings.TelegramRoleOperator, commandRole(cmdPause))
+	assert.Equal(t, settings.TelegramRoleOperator, commandRole(cmdBalances))
+	assert.Equal(t, settings.TelegramRoleOperator, commandRole(cmdPnL))
+	assert.Equal(t, settings.TelegramRoleAdmin, commandRole(cmdSellAll))
+	assert.Equal(t, settings.TelegramRoleAdmin, commandRole("unknown"))
+	assert.Equal(t, settings.TelegramRoleAdmin, actionRole("unknown"))
+
+	// every command must have its role specified explicitly.
+	for c := range helpList {
+		_, ok := commandRoles[c]
+		assert.True(t, ok, c)
+	}
+
+	// every action must be confirmed by at least
+	// the role which can request it.
+	assert.Equal(t, commandRole(cmdPause), actionRole(actionPause))
+	assert.Equal(t, commandRole(cmdResume), actionRole(actionResume))
+	assert.Equal(t, commandRole(cmdSellAll), actionRole(actionSellAll))
+	assert.Equal(t, commandRole(cmdCancelAll), actionRole(actionCancelAll))
+	assert.Equal(t, commandRole(cmdForceSell), actionRole(actionForceSell))
+}
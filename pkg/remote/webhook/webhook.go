@@ -0,0 +1,196 @@
+// Package webhook implements outbound webhooks which post
+// bot's events to external HTTP endpoints.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"eonbot/pkg/event"
+	"eonbot/pkg/settings"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SignatureHeader specifies header containing
+	// hex encoded HMAC-SHA256 signature of the body.
+	SignatureHeader = "X-EonBot-Signature"
+
+	// EventHeader specifies header containing
+	// event's type.
+	EventHeader = "X-EonBot-Event"
+
+	requestTimeout = time.Second * 15
+
+	// maxPendingRetries specifies how many events can be
+	// retried at the same time. Failed events are dropped
+	// when the limit is reached.
+	maxPendingRetries = 50
+)
+
+// ErrStopped is returned when retries are interrupted
+// by webhook stopping.
+var ErrStopped = errors.New("webhook stopped")
+
+// Webhook posts events to a single URL.
+type Webhook struct {
+	conf   settings.Webhook
+	client *http.Client
+
+	// delay specifies delay before
+	// the first retry.
+	delay time.Duration
+
+	// pending limits how many events
+	// are retried in the background.
+	pending chan struct{}
+
+	stop chan struct{}
+}
+
+// New creates new webhook.
+func New(conf settings.Webhook) *Webhook {
+	return &Webhook{
+		conf:    conf,
+		client:  &http.Client{Timeout: requestTimeout},
+		delay:   time.Duration(conf.RetryDelay) * time.Second,
+		pending: make(chan struct{}, maxPendingRetries),
+		stop:    make(chan struct{}),
+	}
+}
+
+// Accepts checks whether the event
+// should be posted.
+func (w *Webhook) Accepts(e event.Event) bool {
+	if len(w.conf.Events) == 0 {
+		return true
+	}
+	return event.Types(w.conf.Events...)(e)
+}
+
+// Send posts the event and retries failed requests according
+// to the webhook's retry policy. It blocks until the event is
+// delivered or all retries fail.
+func (w *Webhook) Send(e event.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	retry, err := w.post(e.Type, body)
+	if err == nil || !retry {
+		return err
+	}
+
+	return w.retry(e.Type, body, err)
+}
+
+// Handle posts the event once and, if the request fails, retries
+// it in a separate goroutine, so that a backing off endpoint
+// wouldn't block the following events. Retries' errors are
+// only logged.
+func (w *Webhook) Handle(e event.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	retry, err := w.post(e.Type, body)
+	if err == nil || !retry || w.conf.Retries == 0 {
+		return err
+	}
+
+	select {
+	case w.pending <- struct{}{}:
+	default:
+		return fmt.Errorf("too many events are being retried, %s event dropped: %s", e.Type, err)
+	}
+
+	go func() {
+		defer func() { <-w.pending }()
+		if err := w.retry(e.Type, body, err); err != nil && err != ErrStopped {
+			logrus.WithField("action", "webhook event retrying").Error(err)
+		}
+	}()
+
+	return nil
+}
+
+// retry resends the request with exponential backoff until
+// it succeeds or retries are exhausted. The last error is
+// returned if all retries fail.
+func (w *Webhook) retry(t event.Type, body []byte, err error) error {
+	delay := w.delay
+	for attempt := 0; attempt < w.conf.Retries; attempt++ {
+		select {
+		case <-time.After(delay):
+		case <-w.stop:
+			return ErrStopped
+		}
+		delay *= 2
+
+		var retry bool
+		retry, err = w.post(t, body)
+		if err == nil || !retry {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Stop interrupts retries of the
+// currently sent events.
+func (w *Webhook) Stop() {
+	close(w.stop)
+}
+
+// post sends a single request, it returns whether the
+// request should be retried if it fails.
+func (w *Webhook) post(t event.Type, body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, w.conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(t))
+	if w.conf.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.conf.Secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+
+	// drain body so that the connection could be reused.
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		err := fmt.Errorf("webhook responded with %d status code", resp.StatusCode)
+		// only server errors and rate limiting
+		// might be resolved by retrying.
+		return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests, err
+	}
+
+	return false, nil
+}
+
+// Sign returns hex encoded HMAC-SHA256 signature of
+// the body prefixed with 'sha256='.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
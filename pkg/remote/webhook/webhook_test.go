@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"encoding/json"
+	"eonbot/pkg/event"
+	"eonbot/pkg/settings"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// receiver is a local HTTP server which responds
+// with specified status codes (the last one is
+// repeated) and records requests.
+type receiver struct {
+	mu       sync.Mutex
+	codes    []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+
+	code := r.codes[0]
+	if len(r.codes) > 1 {
+		r.codes = r.codes[1:]
+	}
+	w.WriteHeader(code)
+}
+
+func TestWebhookSend(t *testing.T) {
+	e := event.Event{
+		Type:      event.OrderFilled,
+		Timestamp: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		Payload:   event.OrderPayload{Pair: "ETH_BTC", ID: "1", Side: "buy"},
+	}
+
+	tests := []struct {
+		Name        string
+		Codes       []int
+		Retries     int
+		Requests    int
+		ShouldError bool
+	}{
+		{
+			Name:     "Successful delivery",
+			Codes:    []int{200},
+			Requests: 1,
+		},
+		{
+			Name:     "Successful delivery after retries",
+			Codes:    []int{500, 429, 204},
+			Retries:  3,
+			Requests: 3,
+		},
+		{
+			Name:        "Unsuccessful delivery when retries are exhausted",
+			Codes:       []int{503},
+			Retries:     2,
+			Requests:    3,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful delivery without retries on client error",
+			Codes:       []int{400},
+			Retries:     3,
+			Requests:    1,
+			ShouldError: true,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			rec := &receiver{codes: v.Codes}
+			srv := httptest.NewServer(rec)
+			defer srv.Close()
+
+			w := New(settings.Webhook{URL: srv.URL, Secret: "secret", Retries: v.Retries})
+			w.delay = time.Millisecond
+
+			err := w.Send(e)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			assert.Len(t, rec.requests, v.Requests)
+
+			req, body := rec.requests[0], rec.bodies[0]
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			assert.Equal(t, string(event.OrderFilled), req.Header.Get(EventHeader))
+			assert.Equal(t, Sign("secret", body), req.Header.Get(SignatureHeader))
+
+			var got map[string]interface{}
+			assert.Nil(t, json.Unmarshal(body, &got))
+			assert.Equal(t, "order-filled", got["event"])
+			assert.Equal(t, "ETH_BTC", got["payload"].(map[string]interface{})["pair"])
+		})
+	}
+}
+
+func TestWebhookStop(t *testing.T) {
+	rec := &receiver{codes: []int{500}}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	w := New(settings.Webhook{URL: srv.URL, Retries: 5, RetryDelay: 60})
+
+	done := make(chan error)
+	go func() {
+		done <- w.Send(event.Event{Type: event.CycleEnd})
+	}()
+
+	time.Sleep(time.Millisecond * 50)
+	w.Stop()
+
+	select {
+	case err := <-done:
+		assert.Equal(t, ErrStopped, err)
+	case <-time.After(time.Second):
+		t.Fatal("retries were not interrupted")
+	}
+}
+
+func TestWebhookHandle(t *testing.T) {
+	rec := &receiver{codes: []int{500, 200}}
+	srv := httptest.NewServer(rec)
+	defer srv.Close()
+
+	w := New(settings.Webhook{URL: srv.URL, Retries: 1, RetryDelay: 60})
+	w.delay = time.Millisecond * 200
+	defer w.Stop()
+
+	// failed request is retried in the background,
+	// so the following events are not blocked.
+	start := time.Now()
+	assert.Nil(t, w.Handle(event.Event{Type: event.StateChange}))
+	assert.Nil(t, w.Handle(event.Event{Type: event.CycleEnd}))
+	assert.True(t, time.Since(start) < w.delay)
+
+	time.Sleep(w.delay * 2)
+
+	rec.mu.Lock()
+	if assert.Len(t, rec.requests, 3) {
+		assert.Equal(t, string(event.StateChange), rec.requests[0].Header.Get(EventHeader))
+		assert.Equal(t, string(event.CycleEnd), rec.requests[1].Header.Get(EventHeader))
+		assert.Equal(t, string(event.StateChange), rec.requests[2].Header.Get(EventHeader))
+	}
+	rec.codes = []int{400}
+	rec.mu.Unlock()
+
+	// client errors are returned without retrying.
+	assert.NotNil(t, w.Handle(event.Event{Type: event.CycleEnd}))
+}
+
+func TestWebhookAccepts(t *testing.T) {
+	w := New(settings.Webhook{})
+	assert.True(t, w.Accepts(event.Event{Type: event.StateChange}))
+
+	w = New(settings.Webhook{Events: []event.Type{event.OrderFilled}})
+	assert.True(t, w.Accepts(event.Event{Type: event.OrderFilled}))
+	assert.False(t, w.Accepts(event.Event{Type: event.StateChange}))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
@@ -0,0 +1,99 @@
+// Package report calculates bot's trading
+// summaries from the saved orders.
+package report
+
+import (
+	"eonbot/pkg/exchange"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Lookback specifies how long before the summary period orders
+// should be retrieved to calculate positions' buy prices.
+const Lookback = time.Hour * 24 * 30
+
+// PairSummary contains single pair's
+// orders summary.
+type PairSummary struct {
+	Pair string
+
+	// Buys and Sells specify filled
+	// orders counts.
+	Buys  int
+	Sells int
+
+	// Bought and Sold specify orders' totals
+	// in counter asset.
+	Bought decimal.Decimal
+	Sold   decimal.Decimal
+
+	// PnL specifies realized profit / loss (in counter asset)
+	// of sell orders, calculated using average buy price
+	// of the position.
+	PnL decimal.Decimal
+}
+
+// Summarize calculates summaries of pairs which had orders filled
+// in the specified period. Orders before the period are used only
+// to calculate positions' buy prices. Summaries are sorted by pair.
+func Summarize(orders map[string][]exchange.BotOrder, start, end time.Time) []PairSummary {
+	sums := make([]PairSummary, 0)
+
+	for pair, oo := range orders {
+		sorted := make([]exchange.BotOrder, len(oo))
+		copy(sorted, oo)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+		})
+
+		sum := PairSummary{Pair: pair}
+		var amount, cost decimal.Decimal
+		for _, ord := range sorted {
+			if ord.Timestamp.After(end) {
+				break
+			}
+
+			inPeriod := !ord.Timestamp.Before(start)
+			total := ord.Amount.Mul(ord.Rate)
+
+			switch ord.Side {
+			case exchange.OrderSideBuy:
+				amount = amount.Add(ord.Amount)
+				cost = cost.Add(total)
+
+				if inPeriod {
+					sum.Buys++
+					sum.Bought = sum.Bought.Add(total)
+				}
+			case exchange.OrderSideSell:
+				sold := decimal.Min(ord.Amount, amount)
+
+				var pnl decimal.Decimal
+				if amount.IsPositive() {
+					avg := cost.Div(amount)
+					pnl = ord.Rate.Sub(avg).Mul(sold)
+					cost = cost.Sub(avg.Mul(sold))
+					amount = amount.Sub(sold)
+				}
+
+				if inPeriod {
+					sum.Sells++
+					sum.Sold = sum.Sold.Add(total)
+					sum.PnL = sum.PnL.Add(pnl)
+				}
+			}
+		}
+
+		if sum.Buys > 0 || sum.Sells > 0 {
+			sums = append(sums, sum)
+		}
+	}
+
+	sort.Slice(sums, func(i, j int) bool {
+		return sums[i].Pair < sums[j].Pair
+	})
+
+	return sums
+}
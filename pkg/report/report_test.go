@@ -0,0 +1,54 @@
+package report
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	start := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 24)
+
+	order := func(ts time.Time, side string, rate, amount int64) exchange.BotOrder {
+		return exchange.NewBotOrder(exchange.Order{
+			Timestamp: ts,
+			IsFilled:  true,
+			Side:      side,
+			Rate:      decimal.New(rate, 0),
+			Amount:    decimal.New(amount, 0),
+		}, "test")
+	}
+
+	orders := map[string][]exchange.BotOrder{
+		"ETH_BTC": {
+			// position opened before the period.
+			order(start.Add(-time.Hour*2), exchange.OrderSideBuy, 10, 2),
+			order(start.Add(time.Hour), exchange.OrderSideBuy, 16, 2),
+			order(start.Add(time.Hour*2), exchange.OrderSideSell, 15, 3),
+			// after the period.
+			order(end.Add(time.Hour), exchange.OrderSideSell, 20, 1),
+		},
+		"DGB_BTC": {
+			order(start.Add(-time.Hour), exchange.OrderSideBuy, 5, 1),
+		},
+		"LTC_BTC": {},
+	}
+
+	sums := Summarize(orders, start, end)
+	assert.Len(t, sums, 1)
+
+	sum := sums[0]
+	assert.Equal(t, "ETH_BTC", sum.Pair)
+	assert.Equal(t, 1, sum.Buys)
+	assert.Equal(t, 1, sum.Sells)
+	assert.Equal(t, "32", sum.Bought.String())
+	assert.Equal(t, "45", sum.Sold.String())
+	// average buy price is 13, so 3 * (15 - 13) = 6.
+	assert.Equal(t, "6", sum.PnL.String())
+
+	assert.Empty(t, Summarize(nil, start, end))
+}
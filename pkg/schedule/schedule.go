@@ -0,0 +1,183 @@
+// Package schedule implements trading sessions (time of day ranges,
+// weekdays and blackout dates) checking logic.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	clockLayout = "15:04"
+	dateLayout  = "2006-01-02"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Schedule specifies when trading is allowed. Empty
+// lists allow any time of day / weekday.
+type Schedule struct {
+	// Timezone specifies IANA timezone name (e.g. Europe/London)
+	// which should be used to check time. UTC is used when empty.
+	Timezone string `json:"timezone" conform:"trim"`
+
+	// Hours specifies time of day ranges when trading is allowed.
+	Hours []Range `json:"hours"`
+
+	// Weekdays specifies days of week when trading is
+	// allowed (sun, mon, tue, wed, thu, fri, sat).
+	Weekdays []string `json:"weekdays" conform:"trim,lower"`
+
+	// Blackout specifies dates (YYYY-MM-DD) when
+	// trading is not allowed.
+	Blackout []string `json:"blackout" conform:"trim"`
+}
+
+// Range specifies time of day range (HH:MM). If To is
+// before From, the range ends on the next day.
+type Range struct {
+	From string `json:"from" conform:"trim"`
+	To   string `json:"to" conform:"trim"`
+}
+
+// locations caches loaded timezones by their names,
+// so that they would be loaded only once.
+var locations sync.Map
+
+// loadLocation loads timezone by its name or
+// retrieves it from the cache.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// Validate checks if Schedule values are valid.
+func (s *Schedule) Validate() error {
+	if _, err := loadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("timezone is invalid: %s", err)
+	}
+
+	for _, r := range s.Hours {
+		from, to, err := r.parse()
+		if err != nil {
+			return err
+		}
+
+		if from == to {
+			return errors.New("hours range cannot be empty")
+		}
+	}
+
+	for _, d := range s.Weekdays {
+		if _, ok := weekdays[strings.ToLower(d)]; !ok {
+			return fmt.Errorf("weekday '%s' is invalid", d)
+		}
+	}
+
+	for _, d := range s.Blackout {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return fmt.Errorf("blackout date '%s' is invalid", d)
+		}
+	}
+
+	return nil
+}
+
+// Active checks whether trading is allowed at the specified time.
+func (s *Schedule) Active(t time.Time) bool {
+	t = s.Local(t)
+
+	date := t.Format(dateLayout)
+	for _, d := range s.Blackout {
+		if d == date {
+			return false
+		}
+	}
+
+	if len(s.Weekdays) > 0 {
+		found := false
+		for _, d := range s.Weekdays {
+			if weekdays[strings.ToLower(d)] == t.Weekday() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if len(s.Hours) == 0 {
+		return true
+	}
+
+	clock := sinceMidnight(t)
+	for _, r := range s.Hours {
+		from, to, err := r.parse()
+		if err != nil {
+			continue
+		}
+
+		if from < to && clock >= from && clock < to {
+			return true
+		}
+
+		// range ends on the next day.
+		if from > to && (clock >= from || clock < to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Local converts time to the schedule's timezone.
+// UTC is used if the timezone is invalid.
+func (s *Schedule) Local(t time.Time) time.Time {
+	loc, err := loadLocation(s.Timezone)
+	if err != nil {
+		return t.UTC()
+	}
+	return t.In(loc)
+}
+
+// parse converts range values to
+// durations since midnight.
+func (r Range) parse() (time.Duration, time.Duration, error) {
+	from, err := time.Parse(clockLayout, r.From)
+	if err != nil {
+		return 0, 0, fmt.Errorf("hours range start '%s' is invalid", r.From)
+	}
+
+	to, err := time.Parse(clockLayout, r.To)
+	if err != nil {
+		return 0, 0, fmt.Errorf("hours range end '%s' is invalid", r.To)
+	}
+
+	return sinceMidnight(from), sinceMidnight(to), nil
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
@@ -0,0 +1,172 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Schedule    Schedule
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when timezone is invalid",
+			Schedule:    Schedule{Timezone: "test"},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when hours range start is invalid",
+			Schedule:    Schedule{Hours: []Range{{From: "25:00", To: "10:00"}}},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when hours range end is invalid",
+			Schedule:    Schedule{Hours: []Range{{From: "09:00", To: "test"}}},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when hours range is empty",
+			Schedule:    Schedule{Hours: []Range{{From: "09:00", To: "09:00"}}},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when weekday is invalid",
+			Schedule:    Schedule{Weekdays: []string{"mon", "test"}},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when blackout date is invalid",
+			Schedule:    Schedule{Blackout: []string{"2020-13-01"}},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation of empty schedule",
+			Schedule:    Schedule{},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation",
+			Schedule: Schedule{
+				Timezone: "America/New_York",
+				Hours:    []Range{{From: "09:30", To: "16:00"}, {From: "22:00", To: "02:00"}},
+				Weekdays: []string{"mon", "Fri"},
+				Blackout: []string{"2020-12-25"},
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Schedule.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleActive(t *testing.T) {
+	// 2020-01-06 is Monday.
+	date := func(day, hour, min int) time.Time {
+		return time.Date(2020, 1, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		Name     string
+		Schedule Schedule
+		Time     time.Time
+		Result   bool
+	}{
+		{
+			Name:     "Empty schedule is always active",
+			Schedule: Schedule{},
+			Time:     date(6, 3, 0),
+			Result:   true,
+		},
+		{
+			Name:     "Time is within hours range",
+			Schedule: Schedule{Hours: []Range{{From: "09:00", To: "17:00"}}},
+			Time:     date(6, 9, 0),
+			Result:   true,
+		},
+		{
+			Name:     "Time is at the end of hours range",
+			Schedule: Schedule{Hours: []Range{{From: "09:00", To: "17:00"}}},
+			Time:     date(6, 17, 0),
+			Result:   false,
+		},
+		{
+			Name:     "Time is within hours range that ends on the next day",
+			Schedule: Schedule{Hours: []Range{{From: "09:00", To: "10:00"}, {From: "22:00", To: "02:00"}}},
+			Time:     date(6, 1, 30),
+			Result:   true,
+		},
+		{
+			Name:     "Time is outside of hours range that ends on the next day",
+			Schedule: Schedule{Hours: []Range{{From: "22:00", To: "02:00"}}},
+			Time:     date(6, 12, 0),
+			Result:   false,
+		},
+		{
+			Name:     "Time is within hours range in specified timezone",
+			Schedule: Schedule{Timezone: "America/New_York", Hours: []Range{{From: "09:30", To: "16:00"}}},
+			Time:     date(6, 15, 0),
+			Result:   true,
+		},
+		{
+			Name:     "Time is outside of hours range in specified timezone",
+			Schedule: Schedule{Timezone: "America/New_York", Hours: []Range{{From: "09:30", To: "16:00"}}},
+			Time:     date(6, 10, 0),
+			Result:   false,
+		},
+		{
+			Name:     "Day is an allowed weekday",
+			Schedule: Schedule{Weekdays: []string{"mon", "tue"}},
+			Time:     date(7, 12, 0),
+			Result:   true,
+		},
+		{
+			Name:     "Day is not an allowed weekday",
+			Schedule: Schedule{Weekdays: []string{"mon", "tue"}},
+			Time:     date(11, 12, 0),
+			Result:   false,
+		},
+		{
+			Name:     "Date is a blackout date",
+			Schedule: Schedule{Blackout: []string{"2020-01-06"}},
+			Time:     date(6, 12, 0),
+			Result:   false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Nil(t, v.Schedule.Validate())
+			assert.Equal(t, v.Result, v.Schedule.Active(v.Time))
+		})
+	}
+}
+
+func TestScheduleLocal(t *testing.T) {
+	tm := time.Date(2020, 1, 6, 15, 0, 0, 0, time.UTC)
+
+	// timezone is loaded without Validate being called.
+	s := Schedule{Timezone: "America/New_York", Hours: []Range{{From: "09:30", To: "16:00"}}}
+	assert.Equal(t, 10, s.Local(tm).Hour())
+	assert.True(t, s.Active(tm))
+
+	// UTC is used when timezone is invalid.
+	s = Schedule{Timezone: "Invalid/Zone"}
+	assert.Equal(t, time.UTC, s.Local(tm).Location())
+}
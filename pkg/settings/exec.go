@@ -63,6 +63,10 @@ type Exec struct {
 	// internal remote controller.
 	RCPort int
 
+	// MetricsPort specifies which port should be used for
+	// metrics endpoint. Metrics are disabled when it's 0.
+	MetricsPort int
+
 	// HTTPTimeout specifies the max amount of time the request should
 	// take to go to the exchange driver and back. In seconds.
 	HTTPTimeout int64
@@ -89,6 +93,16 @@ func (e Exec) Validate() error {
 		return errors.New("remote control port cannot be 1024 or less")
 	}
 
+	if e.MetricsPort != 0 {
+		if e.MetricsPort <= 1024 {
+			return errors.New("metrics port cannot be 1024 or less")
+		}
+
+		if e.MetricsPort == e.RCPort {
+			return errors.New("metrics port cannot be the same as remote control port")
+		}
+	}
+
 	if e.HTTPTimeout < 10 || e.HTTPTimeout > 120 {
 		return errors.New("HTTP timeout should be between 10 and 120 seconds (inclusively)")
 	}
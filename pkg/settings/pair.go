@@ -2,11 +2,13 @@ package settings
 
 import (
 	"encoding/json"
+	"eonbot/pkg/schedule"
 	"eonbot/pkg/strategy"
 	"errors"
 	"fmt"
 
 	"github.com/leebenson/conform"
+	"github.com/shopspring/decimal"
 )
 
 type Pair struct {
@@ -27,6 +29,56 @@ type Pair struct {
 	// OpenOrderLifespan specifies how long should the bot wait (in seconds) til
 	// it should cancel an open order. CancelOpenOrders must be set to true.
 	OpenOrderLifespan int64 `json:"openOrdersLifespan"`
+
+	// Schedule specifies when buy mode strategies are allowed to be
+	// executed. Sell mode strategies are executed at any time.
+	// If nil, buy mode strategies are executed at any time as well.
+	Schedule *schedule.Schedule `json:"schedule"`
+
+	// Throttle specifies how often strategies are allowed to act
+	// after orders are filled. If nil, no limits are applied.
+	Throttle *Throttle `json:"throttle"`
+}
+
+// Throttle contains re-entry limits applied after
+// orders are filled.
+type Throttle struct {
+	// Cooldown specifies how long (in seconds) buy mode strategies
+	// are suspended after any pair's order is filled.
+	Cooldown int64 `json:"cooldown"`
+
+	// StrategyCooldown specifies how long (in seconds) a strategy
+	// is not allowed to act in buy mode after its own order is filled.
+	StrategyCooldown int64 `json:"strategyCooldown"`
+
+	// ReentryChange specifies how much (in percent) the price
+	// must move away from the last sell order's rate before
+	// buy mode strategies are allowed to act again.
+	ReentryChange decimal.Decimal `json:"reentryChange"`
+
+	// MaxDailyTrades specifies how many buy orders can be
+	// filled during the last 24 hours.
+	MaxDailyTrades int `json:"maxDailyTrades"`
+}
+
+func (t Throttle) validate() error {
+	if t.Cooldown < 0 {
+		return errors.New("throttle cooldown cannot be negative")
+	}
+
+	if t.StrategyCooldown < 0 {
+		return errors.New("throttle strategy cooldown cannot be negative")
+	}
+
+	if t.ReentryChange.IsNegative() || t.ReentryChange.GreaterThanOrEqual(decimal.New(100, 0)) {
+		return errors.New("throttle re-entry change must be a value ranging from 0 to 100")
+	}
+
+	if t.MaxDailyTrades < 0 {
+		return errors.New("throttle max daily trades cannot be negative")
+	}
+
+	return nil
 }
 
 func (p Pair) validate() error {
@@ -52,6 +104,18 @@ func (p Pair) validate() error {
 		}
 	}
 
+	if p.Schedule != nil {
+		if err := p.Schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.Throttle != nil {
+		if err := p.Throttle.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
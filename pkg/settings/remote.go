@@ -1,10 +1,21 @@
 package settings
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
-	"github.com/pkg/errors"
+	"eonbot/pkg/event"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
 
 	"github.com/leebenson/conform"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type Remote struct {
@@ -17,6 +28,12 @@ type Remote struct {
 
 	// Internal contains internal RC specific settings.
 	Internal Internal `json:"internal"`
+
+	// Webhooks contains outbound webhooks settings.
+	Webhooks []Webhook `json:"webhooks"`
+
+	// Email contains email notifications settings.
+	Email Email `json:"email"`
 }
 
 func (r *Remote) UnmarshalJSON(d []byte) error {
@@ -53,9 +70,35 @@ func (r Remote) validate() error {
 		return r.annErr(err)
 	}
 
+	for i, w := range r.Webhooks {
+		if err := w.validate(); err != nil {
+			return r.annErr(errors.Wrapf(err, "webhook #%d", i+1))
+		}
+	}
+
+	if err := r.Email.validate(); err != nil {
+		return r.annErr(err)
+	}
+
 	return nil
 }
 
+// Telegram users' roles. Each role is allowed to
+// do everything that lower roles are allowed to.
+const (
+	// TelegramRoleViewer allows to see bot's status
+	// and receive notifications.
+	TelegramRoleViewer = "viewer"
+
+	// TelegramRoleOperator allows to start, stop
+	// and pause the bot or its pairs.
+	TelegramRoleOperator = "operator"
+
+	// TelegramRoleAdmin allows to sell or
+	// cancel all assets/orders.
+	TelegramRoleAdmin = "admin"
+)
+
 type Telegram struct {
 	// Enable specifies whether telegram module should be activated or not.
 	Enable bool `json:"enable"`
@@ -64,8 +107,23 @@ type Telegram struct {
 	Token string `json:"token" conform:"trim"`
 
 	// Owner specifies telegram username who will be able to interact with
-	// the bot.
+	// the bot. Owner has admin role.
 	Owner string `json:"owner" conform:"trim"`
+
+	// Users specifies additional telegram users who will be able
+	// to interact with the bot.
+	Users []TelegramUser `json:"users"`
+}
+
+// TelegramUser contains authorized
+// telegram user's data.
+type TelegramUser struct {
+	// Username specifies user's telegram username.
+	Username string `json:"username" conform:"trim"`
+
+	// Role specifies what the user is allowed to do
+	// (viewer, operator or admin).
+	Role string `json:"role" conform:"trim,lower"`
 }
 
 func (t Telegram) validate() error {
@@ -77,26 +135,389 @@ func (t Telegram) validate() error {
 		return errors.New("telegram token cannot be empty")
 	}
 
-	if t.Owner == "" {
-		return errors.New("telegram owner field cannot be empty")
+	if t.Owner == "" && len(t.Users) == 0 {
+		return errors.New("telegram owner or users must be specified")
+	}
+
+	usernames := make(map[string]struct{})
+	if t.Owner != "" {
+		usernames[strings.ToLower(t.Owner)] = struct{}{}
+	}
+
+	for _, u := range t.Users {
+		if u.Username == "" {
+			return errors.New("telegram user's username cannot be empty")
+		}
+
+		switch u.Role {
+		case TelegramRoleViewer, TelegramRoleOperator, TelegramRoleAdmin:
+		default:
+			return errors.Errorf("telegram user's '%s' role '%s' is invalid", u.Username, u.Role)
+		}
+
+		if _, ok := usernames[strings.ToLower(u.Username)]; ok {
+			return errors.Errorf("telegram user '%s' is specified more than once", u.Username)
+		}
+		usernames[strings.ToLower(u.Username)] = struct{}{}
 	}
 
 	return nil
 }
 
+// UserRole returns role of the telegram user. False is
+// returned if the user is not authorized.
+func (t Telegram) UserRole(username string) (string, bool) {
+	if username == "" {
+		return "", false
+	}
+
+	if strings.EqualFold(username, t.Owner) {
+		return TelegramRoleAdmin, true
+	}
+
+	for _, u := range t.Users {
+		if strings.EqualFold(username, u.Username) {
+			return u.Role, true
+		}
+	}
+
+	return "", false
+}
+
+// Internal RC access scopes. Each scope is allowed to
+// do everything that lower scopes are allowed to.
+const (
+	// InternalScopeReadOnly allows to retrieve bot's
+	// data, state and exchange data.
+	InternalScopeReadOnly = "read-only"
+
+	// InternalScopeTrading allows to start, stop
+	// and restart the bot.
+	InternalScopeTrading = "trading"
+
+	// InternalScopeConfigAdmin allows to manage configs,
+	// exchange API credentials and API tokens.
+	InternalScopeConfigAdmin = "config-admin"
+)
+
 type Internal struct {
+	// Username specifies main RC user's username.
+	// Main user has config-admin scope.
 	Username string `json:"username" conform:"trim"`
+
+	// Password specifies main RC user's password,
+	// either plaintext or bcrypt hash.
 	Password string `json:"password" conform:"trim"`
+
+	// Users specifies additional RC users.
+	Users []InternalUser `json:"users"`
+}
+
+// InternalUser contains internal RC
+// user's credentials and scope.
+type InternalUser struct {
+	Username string `json:"username" conform:"trim"`
+
+	// PasswordHash specifies bcrypt hash
+	// of user's password.
+	PasswordHash string `json:"passwordHash" conform:"trim"`
+
+	// Scope specifies what the user is allowed to do
+	// (read-only, trading or config-admin).
+	Scope string `json:"scope" conform:"trim,lower"`
 }
 
 func (i Internal) validate() error {
-	if i.Username == "" {
+	if i.Username == "" && len(i.Users) == 0 {
 		return errors.New("remote control username cannot be empty")
 	}
 
-	if i.Password == "" {
+	if i.Username != "" && i.Password == "" {
 		return errors.New("remote control password cannot be empty")
 	}
 
+	usernames := make(map[string]struct{})
+	if i.Username != "" {
+		usernames[i.Username] = struct{}{}
+	}
+
+	for _, u := range i.Users {
+		if u.Username == "" {
+			return errors.New("remote control user's username cannot be empty")
+		}
+
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return errors.Errorf("remote control user's '%s' password hash is invalid", u.Username)
+		}
+
+		if !ValidInternalScope(u.Scope) {
+			return errors.Errorf("remote control user's '%s' scope '%s' is invalid", u.Username, u.Scope)
+		}
+
+		if _, ok := usernames[u.Username]; ok {
+			return errors.Errorf("remote control user '%s' is specified more than once", u.Username)
+		}
+		usernames[u.Username] = struct{}{}
+	}
+
+	return nil
+}
+
+// Authorize checks user's credentials and returns
+// user's scope. False is returned if the credentials
+// are incorrect.
+func (i Internal) Authorize(username, password string) (string, bool) {
+	if username == "" {
+		return "", false
+	}
+
+	if i.Username == username {
+		if !checkPassword(username, i.Password, password) {
+			return "", false
+		}
+		return InternalScopeConfigAdmin, true
+	}
+
+	for _, u := range i.Users {
+		if u.Username != username {
+			continue
+		}
+
+		if !compareHash(username, u.PasswordHash, password) {
+			return "", false
+		}
+		return u.Scope, true
+	}
+
+	return "", false
+}
+
+// checkPassword compares password with either plaintext
+// or bcrypt hashed expected password.
+func checkPassword(username, expected, password string) bool {
+	if _, err := bcrypt.Cost([]byte(expected)); err == nil {
+		return compareHash(username, expected, password)
+	}
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(password)) == 1
+}
+
+// verifiedTTL specifies how long successful
+// bcrypt verifications are cached.
+const verifiedTTL = 5 * time.Minute
+
+// verified contains expiration times of successful
+// bcrypt verifications, so that the costly comparison
+// wouldn't be done on every request.
+var verified = struct {
+	sync.Mutex
+	entries map[string]time.Time
+}{entries: make(map[string]time.Time)}
+
+// compareHash compares password with bcrypt hash. Successful
+// comparisons are cached for verifiedTTL duration. Since the
+// hash is a part of the cache key, changed credentials
+// are never matched by stale entries.
+func compareHash(username, hash, password string) bool {
+	sum := sha256.Sum256([]byte(username + "\x00" + hash + "\x00" + password))
+	key := hex.EncodeToString(sum[:])
+	now := time.Now()
+
+	verified.Lock()
+	exp, ok := verified.entries[key]
+	verified.Unlock()
+	if ok && now.Before(exp) {
+		return true
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) != nil {
+		return false
+	}
+
+	verified.Lock()
+	for k, e := range verified.entries {
+		if !now.Before(e) {
+			delete(verified.entries, k)
+		}
+	}
+	verified.entries[key] = now.Add(verifiedTTL)
+	verified.Unlock()
+	return true
+}
+
+// ValidInternalScope checks whether the
+// internal RC scope exists.
+func ValidInternalScope(scope string) bool {
+	switch scope {
+	case InternalScopeReadOnly, InternalScopeTrading, InternalScopeConfigAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+type Webhook struct {
+	// URL specifies address to which events
+	// should be posted.
+	URL string `json:"url" conform:"trim"`
+
+	// Events specifies which events should be posted.
+	// All events are posted when empty.
+	Events []event.Type `json:"events"`
+
+	// Secret specifies key used to sign requests' bodies
+	// (HMAC-SHA256). Requests are not signed when empty.
+	Secret string `json:"secret"`
+
+	// Retries specifies how many times failed
+	// request should be retried.
+	Retries int `json:"retries"`
+
+	// RetryDelay specifies delay before the first retry,
+	// it is doubled after each retry. In seconds.
+	RetryDelay int64 `json:"retryDelay"`
+}
+
+func (w Webhook) validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("webhook url is invalid")
+	}
+
+	for _, e := range w.Events {
+		if !e.IsValid() {
+			return errors.Errorf("webhook event '%s' is invalid", e)
+		}
+	}
+
+	if w.Retries < 0 || w.Retries > 10 {
+		return errors.New("webhook retries count should be between 0 and 10")
+	}
+
+	if w.RetryDelay < 0 {
+		return errors.New("webhook retry delay cannot be negative")
+	}
+
+	return nil
+}
+
+// Email security modes.
+const (
+	EmailSecurityNone     = "none"
+	EmailSecurityStartTLS = "starttls"
+	EmailSecurityTLS      = "tls"
+)
+
+// Email digest periods.
+const (
+	DigestHourly = "hourly"
+	DigestDaily  = "daily"
+)
+
+type Email struct {
+	// Enable specifies whether email notifications should be sent or not.
+	Enable bool `json:"enable"`
+
+	// Host specifies SMTP server's host.
+	Host string `json:"host" conform:"trim"`
+
+	// Port specifies SMTP server's port.
+	Port int `json:"port"`
+
+	// Security specifies connection security mode (none, starttls
+	// or tls). Starttls is used when empty.
+	Security string `json:"security" conform:"trim,lower"`
+
+	// Username specifies SMTP authentication username. Authentication
+	// is not used when empty.
+	Username string `json:"username" conform:"trim"`
+
+	// Password specifies SMTP authentication password.
+	Password string `json:"password"`
+
+	// From specifies sender's address.
+	From string `json:"from" conform:"trim"`
+
+	// Recipients specifies recipients' addresses.
+	Recipients []string `json:"recipients" conform:"trim"`
+
+	// Immediate specifies which events should be sent immediately.
+	// State change and cooldown activation events are sent when
+	// not specified, none are sent when empty.
+	Immediate []event.Type `json:"immediate"`
+
+	// Digest specifies how often (hourly or daily) orders and
+	// PnL summary should be sent. Digest is not sent when empty.
+	Digest string `json:"digest" conform:"trim,lower"`
+
+	// Templates contains custom messages templates.
+	Templates EmailTemplates `json:"templates"`
+}
+
+// EmailTemplates contains Go text/template templates of messages'
+// subjects and bodies. Default templates are used for empty ones.
+type EmailTemplates struct {
+	ImmediateSubject string `json:"immediateSubject"`
+	Immediate        string `json:"immediate"`
+	DigestSubject    string `json:"digestSubject"`
+	Digest           string `json:"digest"`
+}
+
+func (e Email) validate() error {
+	if !e.Enable {
+		return nil
+	}
+
+	if e.Host == "" {
+		return errors.New("email host cannot be empty")
+	}
+
+	if e.Port < 1 || e.Port > 65535 {
+		return errors.New("email port is invalid")
+	}
+
+	switch e.Security {
+	case "", EmailSecurityNone, EmailSecurityStartTLS, EmailSecurityTLS:
+	default:
+		return errors.Errorf("email security mode '%s' is invalid", e.Security)
+	}
+
+	if _, err := mail.ParseAddress(e.From); err != nil {
+		return errors.Errorf("email sender address '%s' is invalid", e.From)
+	}
+
+	if len(e.Recipients) == 0 {
+		return errors.New("email recipients list cannot be empty")
+	}
+
+	for _, r := range e.Recipients {
+		if _, err := mail.ParseAddress(r); err != nil {
+			return errors.Errorf("email recipient address '%s' is invalid", r)
+		}
+	}
+
+	for _, t := range e.Immediate {
+		if !t.IsValid() {
+			return errors.Errorf("email immediate event '%s' is invalid", t)
+		}
+	}
+
+	switch e.Digest {
+	case "", DigestHourly, DigestDaily:
+	default:
+		return errors.Errorf("email digest period '%s' is invalid", e.Digest)
+	}
+
+	for name, tmpl := range map[string]string{
+		"immediate subject": e.Templates.ImmediateSubject,
+		"immediate":         e.Templates.Immediate,
+		"digest subject":    e.Templates.DigestSubject,
+		"digest":            e.Templates.Digest,
+	} {
+		if _, err := template.New(name).Parse(tmpl); err != nil {
+			return errors.Wrapf(err, "email %s template is invalid", name)
+		}
+	}
+
 	return nil
 }
@@ -129,6 +129,20 @@ func (c *container) snapshot() map[string]tools.FullSnapshot {
 	return res
 }
 
+// toolsList returns all tools of the container
+// and its inner-containers.
+func (c *container) toolsList() []*Tool {
+	if c.isTool() {
+		return []*Tool{c.tool}
+	}
+
+	if c.isSeq() {
+		return c.seq.toolsList()
+	}
+
+	return nil
+}
+
 func (c *container) conditionsMet(d exchange.Data) (bool, error) {
 	if err := c.isUndefinedErr(); err != nil {
 		return false, err
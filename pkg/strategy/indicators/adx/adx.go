@@ -0,0 +1,150 @@
+// Package adx implements ADX (Average Directional Index) and
+// DMI (Directional Movement Index) indicators calculation logic.
+package adx
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/atr"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type ADX interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (ADXInfo, error)
+}
+
+// adx contains internal data values needed
+// to calculate ADXInfo values.
+type adx struct {
+	period int
+	offset int
+}
+
+// ADXInfo contains result values of
+// adx.Calc function.
+type ADXInfo struct {
+	ADX     decimal.Decimal `json:"adx"`
+	PlusDI  decimal.Decimal `json:"plusDI"`
+	MinusDI decimal.Decimal `json:"minusDI"`
+}
+
+// New creates new adx object with provided period
+// and offset to make further calculations.
+func New(period, offset int) (*adx, error) {
+	if period <= 0 {
+		return nil, errors.New("ADX period must be positive")
+	}
+
+	return &adx{
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new adx object the same way as New,
+// it just takes values from provided ADXConfig.
+func NewFromConfig(conf ADXConfig, offset int) (*adx, error) {
+	return New(conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate ADX with the provided period.
+func (a *adx) CandlesCount() int {
+	return a.period*3 + a.offset
+}
+
+// Calc calculates ADX, +DI and -DI of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns ADXInfo and optionally an error.
+// ADX calculation (X represents period count):
+//  0. +DM = current high - previous high, -DM = previous low - current low;
+//     only the greater one of them is used (if positive), the other one
+//     is set to zero;
+//  1. TR, +DM and -DM are smoothed: first value = Sum of X values,
+//     next value = previous value - (previous value / X) + current value;
+//  2. +DI = 100 x smoothed +DM / smoothed TR, -DI = 100 x smoothed -DM / smoothed TR;
+//  3. DX = 100 x abs(+DI - -DI) / (+DI + -DI);
+//  4. First ADX = Sum of X DX values / X;
+//  5. ADX = ((previous ADX) x (X - 1) + current DX) / X;
+func (a *adx) Calc(cc []exchange.Candle) (ADXInfo, error) {
+	start := a.CandlesCount()
+	end := a.offset
+
+	if cc == nil || len(cc) < start {
+		return ADXInfo{}, errors.New("ADX candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+	period := decimal.New(int64(a.period), 0)
+	hundred := decimal.New(100, 0)
+
+	var (
+		tr, plusDM, minusDM decimal.Decimal
+		res                 ADXInfo
+		dxSum               decimal.Decimal
+	)
+
+	for i := 1; i < len(candles); i++ {
+		currTR := atr.TrueRange(candles[i], candles[i-1])
+		currPlusDM, currMinusDM := directionalMovement(candles[i], candles[i-1])
+
+		if i <= a.period {
+			tr = tr.Add(currTR)
+			plusDM = plusDM.Add(currPlusDM)
+			minusDM = minusDM.Add(currMinusDM)
+			if i < a.period {
+				continue
+			}
+		} else {
+			tr = tr.Sub(tr.Div(period)).Add(currTR)
+			plusDM = plusDM.Sub(plusDM.Div(period)).Add(currPlusDM)
+			minusDM = minusDM.Sub(minusDM.Div(period)).Add(currMinusDM)
+		}
+
+		res.PlusDI, res.MinusDI = decimal.Zero, decimal.Zero
+		if !tr.IsZero() {
+			res.PlusDI = plusDM.Div(tr).Mul(hundred)
+			res.MinusDI = minusDM.Div(tr).Mul(hundred)
+		}
+
+		dx := decimal.Zero
+		if sum := res.PlusDI.Add(res.MinusDI); !sum.IsZero() {
+			dx = res.PlusDI.Sub(res.MinusDI).Abs().Div(sum).Mul(hundred)
+		}
+
+		// DX values are collected for X candles to
+		// calculate the first ADX value.
+		if i < a.period*2 {
+			dxSum = dxSum.Add(dx)
+			if i == a.period*2-1 {
+				res.ADX = dxSum.Div(period)
+			}
+			continue
+		}
+
+		res.ADX = res.ADX.Mul(decimal.New(int64(a.period-1), 0)).Add(dx).Div(period)
+	}
+
+	return res, nil
+}
+
+// directionalMovement calculates +DM and -DM of the
+// current candle by using previous candle's values.
+func directionalMovement(curr, prev exchange.Candle) (decimal.Decimal, decimal.Decimal) {
+	up := curr.High.Sub(prev.High)
+	down := prev.Low.Sub(curr.Low)
+
+	plusDM, minusDM := decimal.Zero, decimal.Zero
+	if up.GreaterThan(down) && up.Sign() > 0 {
+		plusDM = up
+	}
+
+	if down.GreaterThan(up) && down.Sign() > 0 {
+		minusDM = down
+	}
+
+	return plusDM, minusDM
+}
@@ -0,0 +1,253 @@
+package adx
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func candle(high, low, close int64) exchange.Candle {
+	return exchange.Candle{
+		High:  decimal.New(high, 0),
+		Low:   decimal.New(low, 0),
+		Close: decimal.New(close, 0),
+	}
+}
+
+func TestADXCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		ADX    *adx
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			ADX: func() *adx {
+				val, _ := New(3, 2)
+				return val
+			}(),
+			Result: 11,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.ADX.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestADXNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful ADX creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful ADX creation",
+			Period:      1,
+			Offset:      1,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestADXCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ADX         *adx
+		Candles     []exchange.Candle
+		Result      ADXInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles ADX when only 2 provided",
+			ADX: func() *adx {
+				val, _ := NewFromConfig(ADXConfig{Period: 5}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 3 candles ADX",
+			ADX: func() *adx {
+				val, _ := New(3, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+			},
+			Result: ADXInfo{
+				ADX:     decimal.RequireFromString("31.9185"),
+				PlusDI:  decimal.RequireFromString("22.4941"),
+				MinusDI: decimal.RequireFromString("22.9676"),
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles ADX with offset set to 1",
+			ADX: func() *adx {
+				val, _ := New(3, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+			},
+			Result: ADXInfo{
+				ADX:     decimal.RequireFromString("66.0871"),
+				PlusDI:  decimal.RequireFromString("41.1125"),
+				MinusDI: decimal.RequireFromString("4.0151"),
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles ADX in downtrend",
+			ADX: func() *adx {
+				val, _ := New(3, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(12, 8, 9),
+				candle(11, 7, 8),
+				candle(10, 6, 7),
+			},
+			Result: ADXInfo{
+				ADX:     decimal.RequireFromString("47.2924"),
+				PlusDI:  decimal.RequireFromString("6.9264"),
+				MinusDI: decimal.RequireFromString("25.4329"),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.ADX.Calc(v.Candles)
+			if !v.Result.ADX.Equal(res.ADX.Round(4)) {
+				t.Errorf("incorrect ADX result; expected: %s, got: %s", v.Result.ADX.String(), res.ADX.String())
+			}
+			if !v.Result.PlusDI.Equal(res.PlusDI.Round(4)) {
+				t.Errorf("incorrect +DI result; expected: %s, got: %s", v.Result.PlusDI.String(), res.PlusDI.String())
+			}
+			if !v.Result.MinusDI.Equal(res.MinusDI.Round(4)) {
+				t.Errorf("incorrect -DI result; expected: %s, got: %s", v.Result.MinusDI.String(), res.MinusDI.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestDirectionalMovement(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Curr    exchange.Candle
+		Prev    exchange.Candle
+		PlusDM  decimal.Decimal
+		MinusDM decimal.Decimal
+	}{
+		{
+			Name:    "Successfully calculated directional movement when up move is greater",
+			Curr:    candle(15, 9, 12),
+			Prev:    candle(11, 8, 10),
+			PlusDM:  decimal.New(4, 0),
+			MinusDM: decimal.Zero,
+		},
+		{
+			Name:    "Successfully calculated directional movement when down move is greater",
+			Curr:    candle(12, 5, 6),
+			Prev:    candle(11, 8, 10),
+			PlusDM:  decimal.Zero,
+			MinusDM: decimal.New(3, 0),
+		},
+		{
+			Name:    "Successfully calculated directional movement of inside candle",
+			Curr:    candle(10, 9, 9),
+			Prev:    candle(11, 8, 10),
+			PlusDM:  decimal.Zero,
+			MinusDM: decimal.Zero,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			plusDM, minusDM := directionalMovement(v.Curr, v.Prev)
+			if !v.PlusDM.Equal(plusDM) {
+				t.Errorf("incorrect +DM result; expected: %s, got: %s", v.PlusDM.String(), plusDM.String())
+			}
+			if !v.MinusDM.Equal(minusDM) {
+				t.Errorf("incorrect -DM result; expected: %s, got: %s", v.MinusDM.String(), minusDM.String())
+			}
+		})
+	}
+}
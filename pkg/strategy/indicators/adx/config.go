@@ -0,0 +1,23 @@
+package adx
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// ADXConfig contains settings needed
+// to calculate ADX.
+type ADXConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate ADX and DI lines.
+	Period int `json:"period"`
+}
+
+// validate checks if ADXConfig values
+// are valid and usable.
+func (a *ADXConfig) Validate() error {
+	if err := ma.PeriodValidation(a.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package adx_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/adx"
+)
+
+type adxMock struct {
+	err    error
+	val    adx.ADXInfo
+	period int
+	offset int
+}
+
+func NewADXMock(val adx.ADXInfo, err error, period, offset int) *adxMock {
+	return &adxMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (a *adxMock) CandlesCount() int {
+	return a.period*3 + a.offset
+}
+
+func (a *adxMock) Calc(cc []exchange.Candle) (adx.ADXInfo, error) {
+	if a.err != nil {
+		return adx.ADXInfo{}, a.err
+	}
+	return a.val, nil
+}
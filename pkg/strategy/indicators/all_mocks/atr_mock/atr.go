@@ -0,0 +1,29 @@
+package atr_mock
+
+import (
+	"eonbot/pkg/exchange"
+
+	"github.com/shopspring/decimal"
+)
+
+type atrMock struct {
+	err    error
+	val    decimal.Decimal
+	period int
+	offset int
+}
+
+func NewATRMock(val decimal.Decimal, err error, period, offset int) *atrMock {
+	return &atrMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (a *atrMock) CandlesCount() int {
+	return a.period*2 + a.offset
+}
+
+func (a *atrMock) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	if a.err != nil {
+		return decimal.Zero, a.err
+	}
+	return a.val, nil
+}
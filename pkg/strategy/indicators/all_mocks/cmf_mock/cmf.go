@@ -0,0 +1,29 @@
+package cmf_mock
+
+import (
+	"eonbot/pkg/exchange"
+
+	"github.com/shopspring/decimal"
+)
+
+type cmfMock struct {
+	err    error
+	val    decimal.Decimal
+	period int
+	offset int
+}
+
+func NewCMFMock(val decimal.Decimal, err error, period, offset int) *cmfMock {
+	return &cmfMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (c *cmfMock) CandlesCount() int {
+	return c.period + c.offset
+}
+
+func (c *cmfMock) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	if c.err != nil {
+		return decimal.Zero, c.err
+	}
+	return c.val, nil
+}
@@ -0,0 +1,28 @@
+package donchian_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/donchian"
+)
+
+type donchianMock struct {
+	err    error
+	val    donchian.DonchianInfo
+	period int
+	offset int
+}
+
+func NewDonchianMock(val donchian.DonchianInfo, err error, period, offset int) *donchianMock {
+	return &donchianMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (d *donchianMock) CandlesCount() int {
+	return d.period + d.offset
+}
+
+func (d *donchianMock) Calc(cc []exchange.Candle) (donchian.DonchianInfo, error) {
+	if d.err != nil {
+		return donchian.DonchianInfo{}, d.err
+	}
+	return d.val, nil
+}
@@ -0,0 +1,28 @@
+package ichimoku_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ichimoku"
+)
+
+type ichimokuMock struct {
+	err    error
+	val    ichimoku.IchimokuInfo
+	period int
+	offset int
+}
+
+func NewIchimokuMock(val ichimoku.IchimokuInfo, err error, period, offset int) *ichimokuMock {
+	return &ichimokuMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (i *ichimokuMock) CandlesCount() int {
+	return i.period + i.offset
+}
+
+func (i *ichimokuMock) Calc(cc []exchange.Candle) (ichimoku.IchimokuInfo, error) {
+	if i.err != nil {
+		return ichimoku.IchimokuInfo{}, i.err
+	}
+	return i.val, nil
+}
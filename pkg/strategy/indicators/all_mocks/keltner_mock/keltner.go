@@ -0,0 +1,28 @@
+package keltner_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/keltner"
+)
+
+type keltnerMock struct {
+	err    error
+	val    keltner.KeltnerInfo
+	period int
+	offset int
+}
+
+func NewKeltnerMock(val keltner.KeltnerInfo, err error, period, offset int) *keltnerMock {
+	return &keltnerMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (k *keltnerMock) CandlesCount() int {
+	return k.period + k.offset
+}
+
+func (k *keltnerMock) Calc(cc []exchange.Candle) (keltner.KeltnerInfo, error) {
+	if k.err != nil {
+		return keltner.KeltnerInfo{}, k.err
+	}
+	return k.val, nil
+}
@@ -0,0 +1,29 @@
+package mfi_mock
+
+import (
+	"eonbot/pkg/exchange"
+
+	"github.com/shopspring/decimal"
+)
+
+type mfiMock struct {
+	err    error
+	val    decimal.Decimal
+	period int
+	offset int
+}
+
+func NewMFIMock(val decimal.Decimal, err error, period, offset int) *mfiMock {
+	return &mfiMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (m *mfiMock) CandlesCount() int {
+	return m.period + 1 + m.offset
+}
+
+func (m *mfiMock) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	if m.err != nil {
+		return decimal.Zero, m.err
+	}
+	return m.val, nil
+}
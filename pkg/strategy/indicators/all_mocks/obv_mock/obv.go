@@ -0,0 +1,29 @@
+package obv_mock
+
+import (
+	"eonbot/pkg/exchange"
+
+	"github.com/shopspring/decimal"
+)
+
+type obvMock struct {
+	err    error
+	val    decimal.Decimal
+	period int
+	offset int
+}
+
+func NewOBVMock(val decimal.Decimal, err error, period, offset int) *obvMock {
+	return &obvMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (o *obvMock) CandlesCount() int {
+	return o.period + 1 + o.offset
+}
+
+func (o *obvMock) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	if o.err != nil {
+		return decimal.Zero, o.err
+	}
+	return o.val, nil
+}
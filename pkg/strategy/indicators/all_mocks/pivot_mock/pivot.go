@@ -0,0 +1,28 @@
+package pivot_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/pivot"
+)
+
+type pivotMock struct {
+	err    error
+	val    pivot.PivotInfo
+	period int
+	offset int
+}
+
+func NewPivotMock(val pivot.PivotInfo, err error, period, offset int) *pivotMock {
+	return &pivotMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (p *pivotMock) CandlesCount() int {
+	return p.period + p.offset
+}
+
+func (p *pivotMock) Calc(cc []exchange.Candle) (pivot.PivotInfo, error) {
+	if p.err != nil {
+		return pivot.PivotInfo{}, p.err
+	}
+	return p.val, nil
+}
@@ -0,0 +1,28 @@
+package psar_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/psar"
+)
+
+type psarMock struct {
+	err    error
+	val    psar.PSARInfo
+	period int
+	offset int
+}
+
+func NewPSARMock(val psar.PSARInfo, err error, period, offset int) *psarMock {
+	return &psarMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (p *psarMock) CandlesCount() int {
+	return p.period + p.offset
+}
+
+func (p *psarMock) Calc(cc []exchange.Candle) (psar.PSARInfo, error) {
+	if p.err != nil {
+		return psar.PSARInfo{}, p.err
+	}
+	return p.val, nil
+}
@@ -0,0 +1,28 @@
+package supertrend_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/supertrend"
+)
+
+type superTrendMock struct {
+	err    error
+	val    supertrend.SuperTrendInfo
+	period int
+	offset int
+}
+
+func NewSuperTrendMock(val supertrend.SuperTrendInfo, err error, period, offset int) *superTrendMock {
+	return &superTrendMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (s *superTrendMock) CandlesCount() int {
+	return s.period*3 + s.offset
+}
+
+func (s *superTrendMock) Calc(cc []exchange.Candle) (supertrend.SuperTrendInfo, error) {
+	if s.err != nil {
+		return supertrend.SuperTrendInfo{}, s.err
+	}
+	return s.val, nil
+}
@@ -0,0 +1,28 @@
+package swing_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/swing"
+)
+
+type swingMock struct {
+	err    error
+	val    swing.SwingInfo
+	period int
+	offset int
+}
+
+func NewSwingMock(val swing.SwingInfo, err error, period, offset int) *swingMock {
+	return &swingMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (s *swingMock) CandlesCount() int {
+	return s.period + s.offset
+}
+
+func (s *swingMock) Calc(cc []exchange.Candle) (swing.SwingInfo, error) {
+	if s.err != nil {
+		return swing.SwingInfo{}, s.err
+	}
+	return s.val, nil
+}
@@ -0,0 +1,28 @@
+package volspike_mock
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/volspike"
+)
+
+type volSpikeMock struct {
+	err    error
+	val    volspike.VolSpikeInfo
+	period int
+	offset int
+}
+
+func NewVolSpikeMock(val volspike.VolSpikeInfo, err error, period, offset int) *volSpikeMock {
+	return &volSpikeMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (v *volSpikeMock) CandlesCount() int {
+	return v.period + 1 + v.offset
+}
+
+func (v *volSpikeMock) Calc(cc []exchange.Candle) (volspike.VolSpikeInfo, error) {
+	if v.err != nil {
+		return volspike.VolSpikeInfo{}, v.err
+	}
+	return v.val, nil
+}
@@ -0,0 +1,29 @@
+package vwap_mock
+
+import (
+	"eonbot/pkg/exchange"
+
+	"github.com/shopspring/decimal"
+)
+
+type vwapMock struct {
+	err    error
+	val    decimal.Decimal
+	period int
+	offset int
+}
+
+func NewVWAPMock(val decimal.Decimal, err error, period, offset int) *vwapMock {
+	return &vwapMock{val: val, err: err, period: period, offset: offset}
+}
+
+func (v *vwapMock) CandlesCount() int {
+	return v.period + v.offset
+}
+
+func (v *vwapMock) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	if v.err != nil {
+		return decimal.Zero, v.err
+	}
+	return v.val, nil
+}
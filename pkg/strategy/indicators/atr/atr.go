@@ -0,0 +1,95 @@
+// Package atr implements ATR (Average True Range) indicator calculation logic.
+package atr
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type ATR interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+// atr contains internal data values needed
+// to calculate ATR.
+type atr struct {
+	period int
+	offset int
+}
+
+// New creates new ATR object with provided period
+// and offset to make further calculations.
+func New(period, offset int) (*atr, error) {
+	if period <= 0 {
+		return nil, errors.New("ATR period must be positive")
+	}
+
+	return &atr{
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new ATR object the same way as New,
+// it just takes values from provided ATRConfig.
+func NewFromConfig(conf ATRConfig, offset int) (*atr, error) {
+	return New(conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate ATR with the provided period.
+func (a *atr) CandlesCount() int {
+	return a.period*2 + a.offset
+}
+
+// Calc calculates ATR of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns ATR calculation result and optionally an error.
+// ATR calculation (X represents period count):
+//  0. True range is the greatest of: current high - current low,
+//     abs(current high - previous close), abs(current low - previous close);
+//  1. First ATR = Sum of true ranges over the past X periods / X;
+//  2. ATR = ((previous ATR) x (X - 1) + current true range) / X;
+func (a *atr) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := a.CandlesCount()
+	end := a.offset
+
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("ATR candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	res := decimal.Zero
+	for i := 1; i <= a.period; i++ {
+		res = res.Add(TrueRange(candles[i], candles[i-1]))
+	}
+	res = res.Div(decimal.New(int64(a.period), 0))
+
+	for i := a.period + 1; i < len(candles); i++ {
+		res = res.Mul(decimal.New(int64(a.period-1), 0)).Add(TrueRange(candles[i], candles[i-1])).
+			Div(decimal.New(int64(a.period), 0))
+	}
+
+	return res, nil
+}
+
+// TrueRange calculates true range of the current candle
+// by using previous candle's close price.
+func TrueRange(curr, prev exchange.Candle) decimal.Decimal {
+	res := curr.High.Sub(curr.Low)
+
+	if v := curr.High.Sub(prev.Close).Abs(); v.GreaterThan(res) {
+		res = v
+	}
+
+	if v := curr.Low.Sub(prev.Close).Abs(); v.GreaterThan(res) {
+		res = v
+	}
+
+	return res
+}
@@ -0,0 +1,195 @@
+package atr
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func candle(high, low, close int64) exchange.Candle {
+	return exchange.Candle{
+		High:  decimal.New(high, 0),
+		Low:   decimal.New(low, 0),
+		Close: decimal.New(close, 0),
+	}
+}
+
+func TestATRCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		ATR    *atr
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			ATR: func() *atr {
+				val, _ := New(3, 2)
+				return val
+			}(),
+			Result: 8,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.ATR.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestATRNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful ATR creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful ATR creation",
+			Period:      1,
+			Offset:      1,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestATRCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		ATR         *atr
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles ATR when only 2 provided",
+			ATR: func() *atr {
+				val, _ := NewFromConfig(ATRConfig{Period: 5}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 2 candles ATR",
+			ATR: func() *atr {
+				val, _ := New(2, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+			},
+			Result: decimal.RequireFromString("3.5"),
+		},
+		{
+			Name: "Successfully calculated 2 candles ATR with offset set to 1",
+			ATR: func() *atr {
+				val, _ := New(2, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(100, 1, 50),
+			},
+			Result: decimal.RequireFromString("3.5"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.ATR.Calc(v.Candles)
+			if !v.Result.Equal(res) {
+				t.Errorf("incorrect result; expected: %s, got: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestTrueRange(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Curr   exchange.Candle
+		Prev   exchange.Candle
+		Result decimal.Decimal
+	}{
+		{
+			Name:   "Successfully calculated true range when high and low difference is the greatest",
+			Curr:   candle(15, 5, 10),
+			Prev:   candle(11, 9, 10),
+			Result: decimal.New(10, 0),
+		},
+		{
+			Name:   "Successfully calculated true range when gap up occurred",
+			Curr:   candle(15, 12, 14),
+			Prev:   candle(11, 9, 10),
+			Result: decimal.New(5, 0),
+		},
+		{
+			Name:   "Successfully calculated true range when gap down occurred",
+			Curr:   candle(8, 6, 7),
+			Prev:   candle(11, 9, 10),
+			Result: decimal.New(4, 0),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := TrueRange(v.Curr, v.Prev)
+			if !v.Result.Equal(res) {
+				t.Errorf("incorrect result; expected: %s, got: %s", v.Result.String(), res.String())
+			}
+		})
+	}
+}
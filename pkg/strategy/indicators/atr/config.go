@@ -0,0 +1,23 @@
+package atr
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// ATRConfig contains settings needed
+// to calculate ATR.
+type ATRConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate ATR.
+	Period int `json:"period"`
+}
+
+// validate checks if ATRConfig values
+// are valid and usable.
+func (a *ATRConfig) Validate() error {
+	if err := ma.PeriodValidation(a.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package atr
+
+import "testing"
+
+func TestATRConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      ATRConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      ATRConfig{Period: 300},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      ATRConfig{Period: 14},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Package cmf implements CMF (Chaikin Money Flow) indicator calculation logic.
+package cmf
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type CMF interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+// cmf contains internal data values needed
+// to calculate CMF.
+type cmf struct {
+	period int
+	offset int
+}
+
+// New creates new CMF object with provided period
+// and offset to make further calculations.
+func New(period, offset int) (*cmf, error) {
+	if period <= 0 {
+		return nil, errors.New("CMF period must be positive")
+	}
+
+	return &cmf{
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new CMF object the same way as New,
+// it just takes values from provided CMFConfig.
+func NewFromConfig(conf CMFConfig, offset int) (*cmf, error) {
+	return New(conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate CMF with the provided period.
+func (c *cmf) CandlesCount() int {
+	return c.period + c.offset
+}
+
+// Calc calculates CMF of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns CMF calculation result (ranging from -1 to 1) and
+// optionally an error.
+// CMF calculation:
+//  1. Money flow multiplier = ((close - low) - (high - close)) / (high - low);
+//     if high and low are equal, multiplier is zero;
+//  2. Money flow volume = money flow multiplier x base volume;
+//  3. CMF = Sum(money flow volume) / Sum(base volume);
+func (c *cmf) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := c.CandlesCount()
+	end := c.offset
+
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("CMF candles list is too small")
+	}
+
+	flowVol := decimal.Zero
+	totalVol := decimal.Zero
+	for _, candle := range cc[len(cc)-start : len(cc)-end] {
+		totalVol = totalVol.Add(candle.BaseVolume)
+
+		hl := candle.High.Sub(candle.Low)
+		if hl.IsZero() {
+			continue
+		}
+
+		mult := candle.Close.Sub(candle.Low).Sub(candle.High.Sub(candle.Close)).Div(hl)
+		flowVol = flowVol.Add(mult.Mul(candle.BaseVolume))
+	}
+
+	if totalVol.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	return flowVol.Div(totalVol), nil
+}
@@ -0,0 +1,144 @@
+package cmf
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCMFCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		CMF    *cmf
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			CMF: func() *cmf {
+				val, _ := New(3, 2)
+				return val
+			}(),
+			Result: 5,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.CMF.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestCMFNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful CMF creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful CMF creation",
+			Period:      1,
+			Offset:      1,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCMFCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		CMF         *cmf
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles CMF when only 2 provided",
+			CMF: func() *cmf {
+				val, _ := NewFromConfig(CMFConfig{Period: 5}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(4, 0)},
+				{Close: decimal.New(3, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 2 candles CMF",
+			CMF: func() *cmf {
+				val, _ := New(2, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(10, 0), Low: decimal.Zero, Close: decimal.New(10, 0), BaseVolume: decimal.New(2, 0)},
+				{High: decimal.New(10, 0), Low: decimal.Zero, Close: decimal.Zero, BaseVolume: decimal.New(6, 0)},
+			},
+			Result: decimal.RequireFromString("-0.5"),
+		},
+		{
+			Name: "Successfully calculated 2 candles CMF with zero volume and offset set to 1",
+			CMF: func() *cmf {
+				val, _ := New(2, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(10, 0), Low: decimal.Zero, Close: decimal.New(10, 0)},
+				{High: decimal.New(10, 0), Low: decimal.Zero, Close: decimal.Zero},
+				{High: decimal.New(10, 0), Low: decimal.Zero, Close: decimal.Zero, BaseVolume: decimal.New(6, 0)},
+			},
+			Result: decimal.Zero,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.CMF.Calc(v.Candles)
+			if !v.Result.Equal(res.Round(2)) {
+				t.Errorf("incorrect result; expected: %s, got: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package cmf
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// CMFConfig contains settings needed
+// to calculate CMF.
+type CMFConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate CMF.
+	Period int `json:"period"`
+}
+
+// validate checks if CMFConfig values
+// are valid and usable.
+func (c *CMFConfig) Validate() error {
+	if err := ma.PeriodValidation(c.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package donchian
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// DonchianConfig contains settings needed
+// to calculate Donchian Channels.
+type DonchianConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate Donchian Channels.
+	Period int `json:"period"`
+}
+
+// validate checks if DonchianConfig values
+// are valid and usable.
+func (d *DonchianConfig) Validate() error {
+	if err := ma.PeriodValidation(d.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
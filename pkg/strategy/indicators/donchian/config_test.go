@@ -0,0 +1,39 @@
+package donchian
+
+import "testing"
+
+func TestDonchianConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      DonchianConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      DonchianConfig{Period: 300},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      DonchianConfig{Period: 20},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Package donchian implements Donchian Channels indicator calculation logic.
+package donchian
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type Donchian interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (DonchianInfo, error)
+}
+
+// donchian contains internal data values
+// needed to calculate DonchianInfo values.
+type donchian struct {
+	period int
+	offset int
+}
+
+// DonchianInfo contains result values of
+// donchian.Calc function.
+type DonchianInfo struct {
+	Upper  decimal.Decimal `json:"upper"`
+	Middle decimal.Decimal `json:"middle"`
+	Lower  decimal.Decimal `json:"lower"`
+}
+
+// New creates new donchian object with provided period
+// and offset to make further calculations.
+func New(period, offset int) (*donchian, error) {
+	if period <= 0 {
+		return nil, errors.New("Donchian Channels period must be positive")
+	}
+
+	return &donchian{
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new donchian object the same way as New,
+// it just takes values from provided DonchianConfig.
+func NewFromConfig(conf DonchianConfig, offset int) (*donchian, error) {
+	return New(conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate Donchian Channels with the provided period.
+func (d *donchian) CandlesCount() int {
+	return d.period + d.offset
+}
+
+// Calc calculates Donchian Channels (Middle, Upper, Lower)
+// of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns Donchian Channels calculation result and optionally an error.
+// Donchian Channels calculation:
+// 1. UpperLine = highest high of the period;
+// 2. LowerLine = lowest low of the period;
+// 3. MiddleLine = (UpperLine + LowerLine) / 2;
+func (d *donchian) Calc(cc []exchange.Candle) (DonchianInfo, error) {
+	start := d.CandlesCount()
+	end := d.offset
+
+	if cc == nil || len(cc) < start {
+		return DonchianInfo{}, errors.New("Donchian Channels candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	upper := candles[0].High
+	lower := candles[0].Low
+	for _, c := range candles[1:] {
+		if c.High.GreaterThan(upper) {
+			upper = c.High
+		}
+
+		if c.Low.LessThan(lower) {
+			lower = c.Low
+		}
+	}
+
+	return DonchianInfo{
+		Upper:  upper,
+		Middle: upper.Add(lower).Div(decimal.New(2, 0)),
+		Lower:  lower,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package donchian
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDonchianCandlesCount(t *testing.T) {
+	val, _ := New(3, 2)
+	assert.Equal(t, 5, val.CandlesCount())
+}
+
+func TestDonchianNew(t *testing.T) {
+	_, err := New(0, 1)
+	assert.NotNil(t, err)
+
+	_, err = New(1, 1)
+	assert.Nil(t, err)
+}
+
+func TestDonchianCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Donchian    *donchian
+		Candles     []exchange.Candle
+		Result      DonchianInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles Donchian Channels when only 2 provided",
+			Donchian: func() *donchian {
+				val, _ := NewFromConfig(DonchianConfig{Period: 5}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(4, 0)},
+				{High: decimal.New(3, 0)},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 3 candles Donchian Channels with offset set to 1",
+			Donchian: func() *donchian {
+				val, _ := New(3, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(100, 0), Low: decimal.Zero},
+				{High: decimal.New(5, 0), Low: decimal.New(2, 0)},
+				{High: decimal.New(7, 0), Low: decimal.New(3, 0)},
+				{High: decimal.New(6, 0), Low: decimal.New(1, 0)},
+				{High: decimal.New(50, 0), Low: decimal.Zero},
+			},
+			Result: DonchianInfo{
+				Upper:  decimal.New(7, 0),
+				Middle: decimal.New(4, 0),
+				Lower:  decimal.New(1, 0),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Donchian.Calc(v.Candles)
+			assert.True(t, v.Result.Upper.Equal(res.Upper))
+			assert.True(t, v.Result.Middle.Equal(res.Middle))
+			assert.True(t, v.Result.Lower.Equal(res.Lower))
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package ichimoku
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+)
+
+// IchimokuConfig contains settings needed
+// to calculate Ichimoku Cloud.
+type IchimokuConfig struct {
+	// ConversionPeriod specifies how many candles are
+	// needed to calculate conversion line (Tenkan-sen).
+	// Use 9 when in doubt.
+	ConversionPeriod int `json:"conversionPeriod"`
+
+	// BasePeriod specifies how many candles are
+	// needed to calculate base line (Kijun-sen).
+	// Use 26 when in doubt.
+	BasePeriod int `json:"basePeriod"`
+
+	// SpanBPeriod specifies how many candles are
+	// needed to calculate Senkou Span B.
+	// Use 52 when in doubt.
+	SpanBPeriod int `json:"spanBPeriod"`
+
+	// Displacement specifies by how many candles
+	// the cloud is shifted forward.
+	// Use 26 when in doubt.
+	Displacement int `json:"displacement"`
+}
+
+// validate checks if IchimokuConfig values
+// are valid and usable.
+func (i *IchimokuConfig) Validate() error {
+	if err := ma.PeriodValidation(i.ConversionPeriod); err != nil {
+		return err
+	}
+
+	if err := ma.PeriodValidation(i.BasePeriod); err != nil {
+		return err
+	}
+
+	if err := ma.PeriodValidation(i.SpanBPeriod); err != nil {
+		return err
+	}
+
+	if i.Displacement < 0 || i.Displacement > 200 {
+		return errors.New("displacement must be between 0 and 200")
+	}
+
+	return nil
+}
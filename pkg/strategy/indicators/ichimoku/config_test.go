@@ -0,0 +1,54 @@
+package ichimoku
+
+import "testing"
+
+func TestIchimokuConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      IchimokuConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when conversion period is invalid",
+			Config:      IchimokuConfig{ConversionPeriod: 0, BasePeriod: 26, SpanBPeriod: 52, Displacement: 26},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when base period is invalid",
+			Config:      IchimokuConfig{ConversionPeriod: 9, BasePeriod: 300, SpanBPeriod: 52, Displacement: 26},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when span B period is invalid",
+			Config:      IchimokuConfig{ConversionPeriod: 9, BasePeriod: 26, SpanBPeriod: 300, Displacement: 26},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when displacement is invalid",
+			Config:      IchimokuConfig{ConversionPeriod: 9, BasePeriod: 26, SpanBPeriod: 52, Displacement: -1},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      IchimokuConfig{ConversionPeriod: 9, BasePeriod: 26, SpanBPeriod: 52, Displacement: 26},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
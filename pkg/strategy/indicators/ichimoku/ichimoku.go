@@ -0,0 +1,145 @@
+// Package ichimoku implements Ichimoku Cloud (Ichimoku Kinko Hyo)
+// indicator calculation logic.
+package ichimoku
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type Ichimoku interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (IchimokuInfo, error)
+}
+
+// ichimoku contains internal data values needed
+// to calculate IchimokuInfo values.
+type ichimoku struct {
+	conversionPeriod int
+	basePeriod       int
+	spanBPeriod      int
+	displacement     int
+	offset           int
+}
+
+// IchimokuInfo contains result values of
+// ichimoku.Calc function.
+type IchimokuInfo struct {
+	// ConversionLine specifies Tenkan-sen value.
+	ConversionLine decimal.Decimal `json:"conversionLine"`
+
+	// BaseLine specifies Kijun-sen value.
+	BaseLine decimal.Decimal `json:"baseLine"`
+
+	// SpanA specifies Senkou Span A value of the
+	// current candle's cloud.
+	SpanA decimal.Decimal `json:"spanA"`
+
+	// SpanB specifies Senkou Span B value of the
+	// current candle's cloud.
+	SpanB decimal.Decimal `json:"spanB"`
+}
+
+// CloudTop returns the upper boundary of the cloud.
+func (i IchimokuInfo) CloudTop() decimal.Decimal {
+	return decimal.Max(i.SpanA, i.SpanB)
+}
+
+// CloudBottom returns the lower boundary of the cloud.
+func (i IchimokuInfo) CloudBottom() decimal.Decimal {
+	return decimal.Min(i.SpanA, i.SpanB)
+}
+
+// New creates new ichimoku object with provided data values.
+func New(conversionPeriod, basePeriod, spanBPeriod, displacement, offset int) (*ichimoku, error) {
+	if conversionPeriod <= 0 || basePeriod <= 0 || spanBPeriod <= 0 {
+		return nil, errors.New("Ichimoku Cloud periods must be positive")
+	}
+
+	if displacement < 0 {
+		return nil, errors.New("Ichimoku Cloud displacement cannot be negative")
+	}
+
+	return &ichimoku{
+		conversionPeriod: conversionPeriod,
+		basePeriod:       basePeriod,
+		spanBPeriod:      spanBPeriod,
+		displacement:     displacement,
+		offset:           offset,
+	}, nil
+}
+
+// NewFromConfig creates new ichimoku object the same way as New,
+// it just takes values from provided IchimokuConfig.
+func NewFromConfig(conf IchimokuConfig, offset int) (*ichimoku, error) {
+	return New(conf.ConversionPeriod, conf.BasePeriod, conf.SpanBPeriod, conf.Displacement, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate Ichimoku Cloud with the provided periods.
+func (i *ichimoku) CandlesCount() int {
+	res := i.conversionPeriod
+	if i.basePeriod > res {
+		res = i.basePeriod
+	}
+
+	if i.spanBPeriod > res {
+		res = i.spanBPeriod
+	}
+
+	return res + i.displacement + i.offset
+}
+
+// Calc calculates Ichimoku Cloud lines of provided period values.
+// It will slice out only needed candles (periods, displacement and
+// offset are used to calc boundaries).
+// Returns IchimokuInfo and optionally an error.
+// Ichimoku Cloud calculation (mid(X) represents
+// (highest high + lowest low) / 2 of the last X candles):
+//  0. ConversionLine = mid(conversion period);
+//  1. BaseLine = mid(base period);
+//  2. SpanA = (ConversionLine + BaseLine) / 2, calculated
+//     'displacement' candles ago;
+//  3. SpanB = mid(span B period), calculated
+//     'displacement' candles ago;
+func (i *ichimoku) Calc(cc []exchange.Candle) (IchimokuInfo, error) {
+	start := i.CandlesCount()
+	end := i.offset
+
+	if cc == nil || len(cc) < start {
+		return IchimokuInfo{}, errors.New("Ichimoku Cloud candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+	prev := candles[:len(candles)-i.displacement]
+	two := decimal.New(2, 0)
+
+	return IchimokuInfo{
+		ConversionLine: midpoint(candles, i.conversionPeriod),
+		BaseLine:       midpoint(candles, i.basePeriod),
+		SpanA:          midpoint(prev, i.conversionPeriod).Add(midpoint(prev, i.basePeriod)).Div(two),
+		SpanB:          midpoint(prev, i.spanBPeriod),
+	}, nil
+}
+
+// midpoint calculates the middle value between the highest
+// high and the lowest low of the last period candles.
+func midpoint(cc []exchange.Candle, period int) decimal.Decimal {
+	candles := cc[len(cc)-period:]
+
+	high := candles[0].High
+	low := candles[0].Low
+	for _, c := range candles[1:] {
+		if c.High.GreaterThan(high) {
+			high = c.High
+		}
+
+		if c.Low.LessThan(low) {
+			low = c.Low
+		}
+	}
+
+	return high.Add(low).Div(decimal.New(2, 0))
+}
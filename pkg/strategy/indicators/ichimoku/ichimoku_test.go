@@ -0,0 +1,218 @@
+package ichimoku
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func candle(high, low, close int64) exchange.Candle {
+	return exchange.Candle{
+		High:  decimal.New(high, 0),
+		Low:   decimal.New(low, 0),
+		Close: decimal.New(close, 0),
+	}
+}
+
+func TestIchimokuCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Ichimoku *ichimoku
+		Result   int
+	}{
+		{
+			Name: "Successful count return when span B period is the largest",
+			Ichimoku: func() *ichimoku {
+				val, _ := New(9, 26, 52, 26, 2)
+				return val
+			}(),
+			Result: 80,
+		},
+		{
+			Name: "Successful count return when base period is the largest",
+			Ichimoku: func() *ichimoku {
+				val, _ := New(9, 30, 20, 10, 0)
+				return val
+			}(),
+			Result: 40,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.Ichimoku.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestIchimokuNew(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Periods      [3]int
+		Displacement int
+		ShouldError  bool
+	}{
+		{
+			Name:         "Unsuccessful Ichimoku Cloud creation when period is invalid",
+			Periods:      [3]int{9, 0, 52},
+			Displacement: 26,
+			ShouldError:  true,
+		},
+		{
+			Name:         "Unsuccessful Ichimoku Cloud creation when displacement is invalid",
+			Periods:      [3]int{9, 26, 52},
+			Displacement: -1,
+			ShouldError:  true,
+		},
+		{
+			Name:         "Successful Ichimoku Cloud creation",
+			Periods:      [3]int{9, 26, 52},
+			Displacement: 26,
+			ShouldError:  false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Periods[0], v.Periods[1], v.Periods[2], v.Displacement, 1)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestIchimokuCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Ichimoku    *ichimoku
+		Candles     []exchange.Candle
+		Result      IchimokuInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of Ichimoku Cloud when only 2 candles provided",
+			Ichimoku: func() *ichimoku {
+				val, _ := NewFromConfig(IchimokuConfig{
+					ConversionPeriod: 2,
+					BasePeriod:       3,
+					SpanBPeriod:      5,
+					Displacement:     3,
+				}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated Ichimoku Cloud",
+			Ichimoku: func() *ichimoku {
+				val, _ := New(2, 3, 5, 3, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+			},
+			Result: IchimokuInfo{
+				ConversionLine: decimal.RequireFromString("17.5"),
+				BaseLine:       decimal.RequireFromString("17.5"),
+				SpanA:          decimal.RequireFromString("15.5"),
+				SpanB:          decimal.RequireFromString("13.5"),
+			},
+		},
+		{
+			Name: "Successfully calculated Ichimoku Cloud with offset set to 1",
+			Ichimoku: func() *ichimoku {
+				val, _ := New(2, 3, 5, 3, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+				candle(100, 1, 50),
+			},
+			Result: IchimokuInfo{
+				ConversionLine: decimal.RequireFromString("17.5"),
+				BaseLine:       decimal.RequireFromString("17.5"),
+				SpanA:          decimal.RequireFromString("15.5"),
+				SpanB:          decimal.RequireFromString("13.5"),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Ichimoku.Calc(v.Candles)
+			if !v.Result.ConversionLine.Equal(res.ConversionLine) {
+				t.Errorf("incorrect conversion line result; expected: %s, got: %s", v.Result.ConversionLine.String(), res.ConversionLine.String())
+			}
+			if !v.Result.BaseLine.Equal(res.BaseLine) {
+				t.Errorf("incorrect base line result; expected: %s, got: %s", v.Result.BaseLine.String(), res.BaseLine.String())
+			}
+			if !v.Result.SpanA.Equal(res.SpanA) {
+				t.Errorf("incorrect span A result; expected: %s, got: %s", v.Result.SpanA.String(), res.SpanA.String())
+			}
+			if !v.Result.SpanB.Equal(res.SpanB) {
+				t.Errorf("incorrect span B result; expected: %s, got: %s", v.Result.SpanB.String(), res.SpanB.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestIchimokuInfoCloud(t *testing.T) {
+	info := IchimokuInfo{SpanA: decimal.New(5, 0), SpanB: decimal.New(8, 0)}
+	if !info.CloudTop().Equal(decimal.New(8, 0)) {
+		t.Errorf("incorrect cloud top; expected: 8, got: %s", info.CloudTop().String())
+	}
+	if !info.CloudBottom().Equal(decimal.New(5, 0)) {
+		t.Errorf("incorrect cloud bottom; expected: 5, got: %s", info.CloudBottom().String())
+	}
+}
@@ -0,0 +1,59 @@
+package keltner
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// KeltnerConfig contains settings needed
+// to calculate Keltner Channels.
+type KeltnerConfig struct {
+	// Period specifies how many candles/values are
+	// needed to calculate middle line MA.
+	Period int `json:"period"`
+
+	// ATRPeriod specifies how many candles are
+	// needed to calculate ATR.
+	ATRPeriod int `json:"atrPeriod"`
+
+	// Multiplier specifies value that will be used
+	// to multiply ATR when calculating Upper/Lower lines.
+	Multiplier decimal.Decimal `json:"multiplier"`
+
+	// Price specifies which candle price (Open, High, Low, Close)
+	// should be used when calculating middle line MA.
+	Price string `json:"price" conform:"trim,lower"`
+
+	// MAType specifies which MA (SMA, EMA, WMA) should be used
+	// as a middle line when calculating Keltner Channels.
+	MAType string `json:"maType" conform:"trim,lower"`
+}
+
+// validate checks if KeltnerConfig values
+// are valid and usable.
+func (k *KeltnerConfig) Validate() error {
+	if err := ma.PeriodValidation(k.Period); err != nil {
+		return err
+	}
+
+	if err := ma.PeriodValidation(k.ATRPeriod); err != nil {
+		return err
+	}
+
+	if k.Multiplier.LessThanOrEqual(decimal.Zero) {
+		return errors.New("multiplier must be a positive value")
+	}
+
+	if err := exchange.CandlePriceValid(k.Price); err != nil {
+		return err
+	}
+
+	if err := ma.MATypeValidation(k.MAType); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package keltner
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestKeltnerConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      KeltnerConfig
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when period is invalid",
+			Config: KeltnerConfig{
+				Period:     300,
+				ATRPeriod:  10,
+				Multiplier: decimal.New(2, 0),
+				Price:      exchange.ClosePrice,
+				MAType:     ma.EMAName,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when ATR period is invalid",
+			Config: KeltnerConfig{
+				Period:     20,
+				ATRPeriod:  0,
+				Multiplier: decimal.New(2, 0),
+				Price:      exchange.ClosePrice,
+				MAType:     ma.EMAName,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when multiplier is not positive",
+			Config: KeltnerConfig{
+				Period:     20,
+				ATRPeriod:  10,
+				Multiplier: decimal.Zero,
+				Price:      exchange.ClosePrice,
+				MAType:     ma.EMAName,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when price is invalid",
+			Config: KeltnerConfig{
+				Period:     20,
+				ATRPeriod:  10,
+				Multiplier: decimal.New(2, 0),
+				Price:      "test",
+				MAType:     ma.EMAName,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when MA type is invalid",
+			Config: KeltnerConfig{
+				Period:     20,
+				ATRPeriod:  10,
+				Multiplier: decimal.New(2, 0),
+				Price:      exchange.ClosePrice,
+				MAType:     "test",
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Config: KeltnerConfig{
+				Period:     20,
+				ATRPeriod:  10,
+				Multiplier: decimal.New(2, 0),
+				Price:      exchange.ClosePrice,
+				MAType:     ma.EMAName,
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Package keltner implements Keltner Channels indicator calculation logic.
+package keltner
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators"
+	"eonbot/pkg/strategy/indicators/atr"
+	"eonbot/pkg/strategy/indicators/ma"
+
+	"github.com/shopspring/decimal"
+)
+
+type Keltner interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (KeltnerInfo, error)
+}
+
+// keltner contains internal data values
+// needed to calculate KeltnerInfo values.
+type keltner struct {
+	multiplier decimal.Decimal
+	midMA      ma.MA
+	atr        atr.ATR
+}
+
+// KeltnerInfo contains result values of
+// keltner.Calc function.
+type KeltnerInfo struct {
+	Upper  decimal.Decimal `json:"upper"`
+	Middle decimal.Decimal `json:"middle"`
+	Lower  decimal.Decimal `json:"lower"`
+}
+
+// New creates new keltner object with provided data values.
+func New(period, atrPeriod, offset int, multiplier decimal.Decimal, maType, price string) (*keltner, error) {
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	midMA, err := indicators.NewMA(maType, price, period, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	atr, err := atr.New(atrPeriod, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKeltner(multiplier, midMA, atr), nil
+}
+
+// NewFromConfig creates new keltner object the same way as New,
+// it just takes values from provided KeltnerConfig.
+func NewFromConfig(conf KeltnerConfig, offset int) (*keltner, error) {
+	return New(conf.Period, conf.ATRPeriod, offset, conf.Multiplier, conf.MAType, conf.Price)
+}
+
+// newKeltner creates new keltner object with specfied data values.
+func newKeltner(multiplier decimal.Decimal, midMA ma.MA, atr atr.ATR) *keltner {
+	return &keltner{
+		multiplier: multiplier,
+		midMA:      midMA,
+		atr:        atr,
+	}
+}
+
+// CandlesCount returns min candle count needed
+// to calculate Keltner Channels with the provided periods.
+func (k *keltner) CandlesCount() int {
+	if k.atr.CandlesCount() > k.midMA.CandlesCount() {
+		return k.atr.CandlesCount()
+	}
+	return k.midMA.CandlesCount()
+}
+
+// Calc calculates Keltner Channels (Middle, Upper, Lower) of
+// provided period values.
+// Returns Keltner Channels calculation result and optionally an error.
+// Keltner Channels calculation (X represents period count):
+// 1. MiddleLine = X period MA;
+// 2. UpperLine = MiddleLine + (ATR x multiplier);
+// 3. LowerLine = MiddleLine - (ATR x multiplier);
+func (k *keltner) Calc(cc []exchange.Candle) (KeltnerInfo, error) {
+	mid, err := k.midMA.Calc(cc)
+	if err != nil {
+		return KeltnerInfo{}, err
+	}
+
+	atr, err := k.atr.Calc(cc)
+	if err != nil {
+		return KeltnerInfo{}, err
+	}
+
+	return KeltnerInfo{
+		Upper:  mid.Add(atr.Mul(k.multiplier)),
+		Middle: mid,
+		Lower:  mid.Sub(atr.Mul(k.multiplier)),
+	}, nil
+}
@@ -0,0 +1,153 @@
+package keltner
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/atr_mock"
+	"eonbot/pkg/strategy/indicators/all_mocks/sma_mock"
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeltnerNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Price       string
+		MAType      string
+		ATRPeriod   int
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful Keltner creation when price is invalid",
+			Price:       "test",
+			MAType:      ma.EMAName,
+			ATRPeriod:   2,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful Keltner creation when MA type is invalid",
+			Price:       exchange.ClosePrice,
+			MAType:      "test",
+			ATRPeriod:   2,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful Keltner creation when ATR period is invalid",
+			Price:       exchange.ClosePrice,
+			MAType:      ma.EMAName,
+			ATRPeriod:   0,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful Keltner creation",
+			Price:       exchange.ClosePrice,
+			MAType:      ma.EMAName,
+			ATRPeriod:   2,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(3, v.ATRPeriod, 0, decimal.New(2, 0), v.MAType, v.Price)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestKeltnerCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Keltner *keltner
+		Result  int
+	}{
+		{
+			Name:    "Successful count return when MA needs more candles",
+			Keltner: newKeltner(decimal.New(2, 0), sma_mock.NewSMAMock(decimal.Zero, nil, 20, 0), atr_mock.NewATRMock(decimal.Zero, nil, 5, 0)),
+			Result:  20,
+		},
+		{
+			Name:    "Successful count return when ATR needs more candles",
+			Keltner: newKeltner(decimal.New(2, 0), sma_mock.NewSMAMock(decimal.Zero, nil, 5, 0), atr_mock.NewATRMock(decimal.Zero, nil, 10, 0)),
+			Result:  20,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, v.Result, v.Keltner.CandlesCount())
+		})
+	}
+}
+
+func TestKeltnerCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Keltner     *keltner
+		Candles     []exchange.Candle
+		Result      KeltnerInfo
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful calculation when MA returns an error",
+			Keltner:     newKeltner(decimal.New(2, 0), sma_mock.NewSMAMock(decimal.Zero, errors.New("test"), 1, 0), atr_mock.NewATRMock(decimal.Zero, nil, 1, 0)),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful calculation when ATR returns an error",
+			Keltner:     newKeltner(decimal.New(2, 0), sma_mock.NewSMAMock(decimal.Zero, nil, 1, 0), atr_mock.NewATRMock(decimal.Zero, errors.New("test"), 1, 0)),
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated Keltner Channels",
+			Keltner: func() *keltner {
+				val, _ := NewFromConfig(KeltnerConfig{
+					Period:     2,
+					ATRPeriod:  2,
+					Multiplier: decimal.New(2, 0),
+					Price:      exchange.ClosePrice,
+					MAType:     ma.SMAName,
+				}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(10, 0), Low: decimal.New(8, 0), Close: decimal.New(9, 0)},
+				{High: decimal.New(12, 0), Low: decimal.New(9, 0), Close: decimal.New(11, 0)},
+				{High: decimal.New(11, 0), Low: decimal.New(10, 0), Close: decimal.New(10, 0)},
+				{High: decimal.New(15, 0), Low: decimal.New(12, 0), Close: decimal.New(14, 0)},
+			},
+			Result: KeltnerInfo{
+				Upper:  decimal.New(19, 0),
+				Middle: decimal.New(12, 0),
+				Lower:  decimal.New(5, 0),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Keltner.Calc(v.Candles)
+			assert.True(t, v.Result.Upper.Equal(res.Upper))
+			assert.True(t, v.Result.Middle.Equal(res.Middle))
+			assert.True(t, v.Result.Lower.Equal(res.Lower))
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -2,8 +2,13 @@ package indicators
 
 import (
 	"eonbot/pkg/strategy/indicators/ma"
+	"eonbot/pkg/strategy/indicators/ma/dema"
 	"eonbot/pkg/strategy/indicators/ma/ema"
+	"eonbot/pkg/strategy/indicators/ma/hma"
+	"eonbot/pkg/strategy/indicators/ma/kama"
 	"eonbot/pkg/strategy/indicators/ma/sma"
+	"eonbot/pkg/strategy/indicators/ma/tema"
+	"eonbot/pkg/strategy/indicators/ma/vwma"
 	"eonbot/pkg/strategy/indicators/ma/wma"
 )
 
@@ -16,6 +21,16 @@ func NewMA(maType, price string, period, offset int) (ma.MA, error) {
 		return ema.New(period, offset, price)
 	case ma.WMAName:
 		return wma.New(period, offset, price)
+	case ma.DEMAName:
+		return dema.New(period, offset, price)
+	case ma.TEMAName:
+		return tema.New(period, offset, price)
+	case ma.HMAName:
+		return hma.New(period, offset, price)
+	case ma.KAMAName:
+		return kama.New(period, offset, price)
+	case ma.VWMAName:
+		return vwma.New(period, offset, price)
 	default:
 		return nil, ma.ErrMATypeInvalid
 	}
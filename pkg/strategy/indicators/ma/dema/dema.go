@@ -0,0 +1,91 @@
+// Package dema implements DEMA (Double Exponential Moving Average)
+// indicator calculation logic.
+package dema
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"eonbot/pkg/strategy/indicators/ma/ema"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type DEMA interface{ ma.MA }
+
+// dema contains internal data values needed
+// to calculate DEMA.
+type dema struct {
+	period int
+	offset int
+	price  string
+}
+
+// New creates new dema object with provided data values
+// to make further calculations.
+func New(period, offset int, price string) (*dema, error) {
+	if period <= 0 {
+		return nil, errors.New("DEMA period must be positive")
+	}
+
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	return &dema{
+		period: period,
+		offset: offset,
+		price:  price,
+	}, nil
+}
+
+// CandlesCount returns min candle count needed
+// to calculate DEMA with the provided period.
+func (d *dema) CandlesCount() int {
+	return d.period*3 + d.offset
+}
+
+// Calc calculates DEMA of provided period candles.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns DEMA calculation result and optionally an error.
+func (d *dema) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := d.CandlesCount()
+	end := d.offset
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("DEMA candles list is too small")
+	}
+
+	vals := make([]decimal.Decimal, 0, start-end)
+	for _, c := range cc[len(cc)-start : len(cc)-end] {
+		vals = append(vals, c.Price(d.price))
+	}
+
+	return d.calc(vals), nil
+}
+
+// CalcDecimal calculates DEMA of provided period values.
+// It will slice out only needed values (period and offset
+// are used to calc boundaries).
+// Returns DEMA calculation result and optionally an error.
+func (d *dema) CalcDecimal(dd []decimal.Decimal) (decimal.Decimal, error) {
+	start := d.CandlesCount()
+	end := d.offset
+	if dd == nil || len(dd) < start {
+		return decimal.Zero, errors.New("DEMA values list is too small")
+	}
+
+	return d.calc(dd[len(dd)-start : len(dd)-end]), nil
+}
+
+// calc calculates DEMA of provided values.
+// DEMA calculation:
+// 1. EMA1 = EMA of values;
+// 2. EMA2 = EMA of EMA1 values;
+// 3. DEMA = 2 x EMA1 - EMA2;
+func (d *dema) calc(vals []decimal.Decimal) decimal.Decimal {
+	ema1 := ema.Series(vals, d.period)
+	ema2 := ema.Series(ema1, d.period)
+
+	return ema1[len(ema1)-1].Mul(decimal.New(2, 0)).Sub(ema2[len(ema2)-1])
+}
@@ -113,3 +113,29 @@ func (e *ema) CalcDecimal(dd []decimal.Decimal) (decimal.Decimal, error) {
 	}
 	return previousEMA, nil
 }
+
+// Series calculates EMA values of all provided values.
+// The first EMA value is SMA of the first period values, so
+// the result contains len(dd) - period + 1 values.
+// Returns nil if provided values list is too small.
+func Series(dd []decimal.Decimal, period int) []decimal.Decimal {
+	if period <= 0 || len(dd) < period {
+		return nil
+	}
+
+	sum := decimal.Zero
+	for _, d := range dd[:period] {
+		sum = sum.Add(d)
+	}
+
+	res := make([]decimal.Decimal, 0, len(dd)-period+1)
+	res = append(res, sum.Div(decimal.New(int64(period), 0)))
+
+	k := decimal.New(2, 0).Div(decimal.New(int64(period), 0).Add(decimal.New(1, 0)))
+	for _, d := range dd[period:] {
+		prev := res[len(res)-1]
+		res = append(res, d.Sub(prev).Mul(k).Add(prev))
+	}
+
+	return res
+}
@@ -369,3 +369,49 @@ func TestEMACalcDecimal(t *testing.T) {
 		})
 	}
 }
+
+func TestSeries(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Values []decimal.Decimal
+		Period int
+		Result []decimal.Decimal
+	}{
+		{
+			Name:   "Unsuccessful series calc when values list is too small",
+			Values: []decimal.Decimal{decimal.New(10, 0)},
+			Period: 2,
+			Result: nil,
+		},
+		{
+			Name: "Successful series calc",
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(20, 0),
+				decimal.New(30, 0),
+				decimal.New(60, 0),
+			},
+			Period: 3,
+			Result: []decimal.Decimal{
+				decimal.New(20, 0),
+				decimal.New(40, 0),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := Series(v.Values, v.Period)
+			if len(res) != len(v.Result) {
+				t.Fatalf("incorrect result length, expected: %d, got: %d", len(v.Result), len(res))
+			}
+			for i := range res {
+				if !res[i].Equal(v.Result[i]) {
+					t.Errorf("incorrect result at %d, expected: %s, got: %s", i, v.Result[i].String(), res[i].String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Package hma implements HMA (Hull Moving Average)
+// indicator calculation logic.
+package hma
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+type HMA interface{ ma.MA }
+
+// hma contains internal data values needed
+// to calculate HMA.
+type hma struct {
+	period     int
+	halfPeriod int
+	sqrtPeriod int
+	offset     int
+	price      string
+}
+
+// New creates new hma object with provided data values
+// to make further calculations.
+func New(period, offset int, price string) (*hma, error) {
+	if period <= 0 {
+		return nil, errors.New("HMA period must be positive")
+	}
+
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	halfPeriod := period / 2
+	if halfPeriod < 1 {
+		halfPeriod = 1
+	}
+
+	return &hma{
+		period:     period,
+		halfPeriod: halfPeriod,
+		sqrtPeriod: int(math.Sqrt(float64(period))),
+		offset:     offset,
+		price:      price,
+	}, nil
+}
+
+// CandlesCount returns min candle count needed
+// to calculate HMA with the provided period.
+func (h *hma) CandlesCount() int {
+	return h.period + h.sqrtPeriod - 1 + h.offset
+}
+
+// Calc calculates HMA of provided period candles.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns HMA calculation result and optionally an error.
+func (h *hma) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := h.CandlesCount()
+	end := h.offset
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("HMA candles list is too small")
+	}
+
+	vals := make([]decimal.Decimal, 0, start-end)
+	for _, c := range cc[len(cc)-start : len(cc)-end] {
+		vals = append(vals, c.Price(h.price))
+	}
+
+	return h.calc(vals), nil
+}
+
+// CalcDecimal calculates HMA of provided period values.
+// It will slice out only needed values (period and offset
+// are used to calc boundaries).
+// Returns HMA calculation result and optionally an error.
+func (h *hma) CalcDecimal(dd []decimal.Decimal) (decimal.Decimal, error) {
+	start := h.CandlesCount()
+	end := h.offset
+	if dd == nil || len(dd) < start {
+		return decimal.Zero, errors.New("HMA values list is too small")
+	}
+
+	return h.calc(dd[len(dd)-start : len(dd)-end]), nil
+}
+
+// calc calculates HMA of provided values.
+// HMA calculation (X represents period count):
+// 1. Raw HMA = 2 x WMA(X / 2) - WMA(X);
+// 2. HMA = WMA(sqrt(X)) of raw HMA values;
+func (h *hma) calc(vals []decimal.Decimal) decimal.Decimal {
+	raw := make([]decimal.Decimal, 0, h.sqrtPeriod)
+	for i := h.period; i <= len(vals); i++ {
+		full := weighted(vals[i-h.period : i])
+		half := weighted(vals[i-h.halfPeriod : i])
+		raw = append(raw, half.Mul(decimal.New(2, 0)).Sub(full))
+	}
+
+	return weighted(raw)
+}
+
+// weighted calculates WMA of all provided values.
+// The latest value has the highest weight.
+func weighted(dd []decimal.Decimal) decimal.Decimal {
+	sum := decimal.Zero
+	total := decimal.Zero
+	for i, d := range dd {
+		k := decimal.New(int64(i+1), 0)
+		sum = sum.Add(d.Mul(k))
+		total = total.Add(k)
+	}
+
+	return sum.Div(total)
+}
@@ -0,0 +1,234 @@
+package hma
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestHMACandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		HMA    *hma
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			HMA: func() *hma {
+				val, _ := New(4, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Result: 7,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.HMA.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestHMANew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Price       string
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful HMA creation when period is invalid",
+			Period:      0,
+			Price:       exchange.ClosePrice,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful HMA creation when price type is invalid",
+			Period:      1,
+			Price:       "test",
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful HMA creation",
+			Period:      1,
+			Price:       exchange.ClosePrice,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, 1, v.Price)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestHMACalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		HMA         *hma
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful HMA calc when candles list is too small",
+			HMA: func() *hma {
+				val, _ := New(4, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful HMA calc of 4 candles",
+			HMA: func() *hma {
+				val, _ := New(4, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(12, 0)},
+				{Close: decimal.New(11, 0)},
+				{Close: decimal.New(14, 0)},
+				{Close: decimal.New(13, 0)},
+				{Close: decimal.New(15, 0)},
+				{Close: decimal.New(17, 0)},
+				{Close: decimal.New(16, 0)},
+				{Close: decimal.New(18, 0)},
+				{Close: decimal.New(20, 0)},
+				{Close: decimal.New(19, 0)},
+				{Close: decimal.New(21, 0)},
+			},
+			Result: decimal.RequireFromString("20.4667"),
+		},
+		{
+			Name: "Successful HMA calc of 4 candles with offset set to 2",
+			HMA: func() *hma {
+				val, _ := New(4, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(12, 0)},
+				{Close: decimal.New(11, 0)},
+				{Close: decimal.New(14, 0)},
+				{Close: decimal.New(13, 0)},
+				{Close: decimal.New(15, 0)},
+				{Close: decimal.New(17, 0)},
+				{Close: decimal.New(16, 0)},
+				{Close: decimal.New(18, 0)},
+				{Close: decimal.New(20, 0)},
+				{Close: decimal.New(19, 0)},
+				{Close: decimal.New(21, 0)},
+			},
+			Result: decimal.RequireFromString("19.5"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.HMA.Calc(v.Candles)
+			if !res.Round(4).Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestHMACalcDecimal(t *testing.T) {
+	tests := []struct {
+		Name        string
+		HMA         *hma
+		Values      []decimal.Decimal
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful HMA calc when values list is too small",
+			HMA: func() *hma {
+				val, _ := New(4, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(12, 0),
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful HMA calc of 4 values",
+			HMA: func() *hma {
+				val, _ := New(4, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(12, 0),
+				decimal.New(11, 0),
+				decimal.New(14, 0),
+				decimal.New(13, 0),
+				decimal.New(15, 0),
+				decimal.New(17, 0),
+				decimal.New(16, 0),
+				decimal.New(18, 0),
+				decimal.New(20, 0),
+				decimal.New(19, 0),
+				decimal.New(21, 0),
+			},
+			Result: decimal.RequireFromString("20.4667"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.HMA.CalcDecimal(v.Values)
+			if !res.Round(4).Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Package kama implements KAMA (Kaufman's Adaptive Moving Average)
+// indicator calculation logic.
+package kama
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// fastPeriod specifies EMA period which smoothing
+	// constant is used when market is trending.
+	fastPeriod = 2
+
+	// slowPeriod specifies EMA period which smoothing
+	// constant is used when market is ranging.
+	slowPeriod = 30
+)
+
+type KAMA interface{ ma.MA }
+
+// kama contains internal data values needed
+// to calculate KAMA.
+type kama struct {
+	period int
+	offset int
+	price  string
+}
+
+// New creates new kama object with provided data values
+// to make further calculations.
+func New(period, offset int, price string) (*kama, error) {
+	if period <= 0 {
+		return nil, errors.New("KAMA period must be positive")
+	}
+
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	return &kama{
+		period: period,
+		offset: offset,
+		price:  price,
+	}, nil
+}
+
+// CandlesCount returns min candle count needed
+// to calculate KAMA with the provided period.
+func (k *kama) CandlesCount() int {
+	return k.period*2 + k.offset
+}
+
+// Calc calculates KAMA of provided period candles.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns KAMA calculation result and optionally an error.
+func (k *kama) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := k.CandlesCount()
+	end := k.offset
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("KAMA candles list is too small")
+	}
+
+	vals := make([]decimal.Decimal, 0, start-end)
+	for _, c := range cc[len(cc)-start : len(cc)-end] {
+		vals = append(vals, c.Price(k.price))
+	}
+
+	return k.calc(vals), nil
+}
+
+// CalcDecimal calculates KAMA of provided period values.
+// It will slice out only needed values (period and offset
+// are used to calc boundaries).
+// Returns KAMA calculation result and optionally an error.
+func (k *kama) CalcDecimal(dd []decimal.Decimal) (decimal.Decimal, error) {
+	start := k.CandlesCount()
+	end := k.offset
+	if dd == nil || len(dd) < start {
+		return decimal.Zero, errors.New("KAMA values list is too small")
+	}
+
+	return k.calc(dd[len(dd)-start : len(dd)-end]), nil
+}
+
+// calc calculates KAMA of provided values.
+// KAMA calculation (X represents period count):
+//  0. First KAMA = X-th value;
+//  1. Efficiency ratio (ER) = abs(current value - value X periods ago) /
+//     Sum of abs(value - previous value) over the past X periods;
+//  2. Smoothing constant (SC) = (ER x (fast SC - slow SC) + slow SC)^2,
+//     where fast SC = 2 / (2 + 1) and slow SC = 2 / (30 + 1);
+//  3. KAMA = previous KAMA + SC x (current value - previous KAMA);
+func (k *kama) calc(vals []decimal.Decimal) decimal.Decimal {
+	two := decimal.New(2, 0)
+	fastSC := two.Div(decimal.New(fastPeriod+1, 0))
+	slowSC := two.Div(decimal.New(slowPeriod+1, 0))
+
+	res := vals[k.period-1]
+	for i := k.period; i < len(vals); i++ {
+		change := vals[i].Sub(vals[i-k.period]).Abs()
+
+		volatility := decimal.Zero
+		for j := i - k.period + 1; j <= i; j++ {
+			volatility = volatility.Add(vals[j].Sub(vals[j-1]).Abs())
+		}
+
+		er := decimal.Zero
+		if !volatility.IsZero() {
+			er = change.Div(volatility)
+		}
+
+		sc := er.Mul(fastSC.Sub(slowSC)).Add(slowSC)
+		sc = sc.Mul(sc)
+
+		res = res.Add(sc.Mul(vals[i].Sub(res)))
+	}
+
+	return res
+}
@@ -0,0 +1,234 @@
+package kama
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestKAMACandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		KAMA   *kama
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			KAMA: func() *kama {
+				val, _ := New(3, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Result: 8,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.KAMA.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestKAMANew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Price       string
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful KAMA creation when period is invalid",
+			Period:      0,
+			Price:       exchange.ClosePrice,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful KAMA creation when price type is invalid",
+			Period:      1,
+			Price:       "test",
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful KAMA creation",
+			Period:      1,
+			Price:       exchange.ClosePrice,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, 1, v.Price)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestKAMACalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		KAMA        *kama
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful KAMA calc when candles list is too small",
+			KAMA: func() *kama {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful KAMA calc of 3 candles",
+			KAMA: func() *kama {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(12, 0)},
+				{Close: decimal.New(11, 0)},
+				{Close: decimal.New(14, 0)},
+				{Close: decimal.New(13, 0)},
+				{Close: decimal.New(15, 0)},
+				{Close: decimal.New(17, 0)},
+				{Close: decimal.New(16, 0)},
+				{Close: decimal.New(18, 0)},
+				{Close: decimal.New(20, 0)},
+				{Close: decimal.New(19, 0)},
+				{Close: decimal.New(21, 0)},
+			},
+			Result: decimal.RequireFromString("18.9354"),
+		},
+		{
+			Name: "Successful KAMA calc of 3 candles with offset set to 2",
+			KAMA: func() *kama {
+				val, _ := New(3, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(12, 0)},
+				{Close: decimal.New(11, 0)},
+				{Close: decimal.New(14, 0)},
+				{Close: decimal.New(13, 0)},
+				{Close: decimal.New(15, 0)},
+				{Close: decimal.New(17, 0)},
+				{Close: decimal.New(16, 0)},
+				{Close: decimal.New(18, 0)},
+				{Close: decimal.New(20, 0)},
+				{Close: decimal.New(19, 0)},
+				{Close: decimal.New(21, 0)},
+			},
+			Result: decimal.RequireFromString("17.5708"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.KAMA.Calc(v.Candles)
+			if !res.Round(4).Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestKAMACalcDecimal(t *testing.T) {
+	tests := []struct {
+		Name        string
+		KAMA        *kama
+		Values      []decimal.Decimal
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful KAMA calc when values list is too small",
+			KAMA: func() *kama {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(12, 0),
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful KAMA calc of 3 values",
+			KAMA: func() *kama {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(12, 0),
+				decimal.New(11, 0),
+				decimal.New(14, 0),
+				decimal.New(13, 0),
+				decimal.New(15, 0),
+				decimal.New(17, 0),
+				decimal.New(16, 0),
+				decimal.New(18, 0),
+				decimal.New(20, 0),
+				decimal.New(19, 0),
+				decimal.New(21, 0),
+			},
+			Result: decimal.RequireFromString("18.9354"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.KAMA.CalcDecimal(v.Values)
+			if !res.Round(4).Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
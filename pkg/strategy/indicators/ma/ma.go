@@ -12,9 +12,14 @@ import (
 
 // MA types
 const (
-	SMAName = "sma"
-	EMAName = "ema"
-	WMAName = "wma"
+	SMAName  = "sma"
+	EMAName  = "ema"
+	WMAName  = "wma"
+	DEMAName = "dema"
+	TEMAName = "tema"
+	HMAName  = "hma"
+	KAMAName = "kama"
+	VWMAName = "vwma"
 )
 
 var (
@@ -76,7 +81,7 @@ func PeriodValidation(period int) error {
 // MA type string is valid and existing.
 func MATypeValidation(t string) error {
 	switch t {
-	case SMAName, EMAName, WMAName:
+	case SMAName, EMAName, WMAName, DEMAName, TEMAName, HMAName, KAMAName, VWMAName:
 		return nil
 	default:
 		return ErrMATypeInvalid
@@ -65,6 +65,11 @@ func TestMATypeValidation(t *testing.T) {
 			Type:        SMAName,
 			ShouldError: false,
 		},
+		{
+			Name:        "Successful validation of additional MA type",
+			Type:        KAMAName,
+			ShouldError: false,
+		},
 	}
 
 	for _, v := range tests {
@@ -0,0 +1,94 @@
+// Package tema implements TEMA (Triple Exponential Moving Average)
+// indicator calculation logic.
+package tema
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"eonbot/pkg/strategy/indicators/ma/ema"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type TEMA interface{ ma.MA }
+
+// tema contains internal data values needed
+// to calculate TEMA.
+type tema struct {
+	period int
+	offset int
+	price  string
+}
+
+// New creates new tema object with provided data values
+// to make further calculations.
+func New(period, offset int, price string) (*tema, error) {
+	if period <= 0 {
+		return nil, errors.New("TEMA period must be positive")
+	}
+
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	return &tema{
+		period: period,
+		offset: offset,
+		price:  price,
+	}, nil
+}
+
+// CandlesCount returns min candle count needed
+// to calculate TEMA with the provided period.
+func (t *tema) CandlesCount() int {
+	return t.period*4 + t.offset
+}
+
+// Calc calculates TEMA of provided period candles.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns TEMA calculation result and optionally an error.
+func (t *tema) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := t.CandlesCount()
+	end := t.offset
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("TEMA candles list is too small")
+	}
+
+	vals := make([]decimal.Decimal, 0, start-end)
+	for _, c := range cc[len(cc)-start : len(cc)-end] {
+		vals = append(vals, c.Price(t.price))
+	}
+
+	return t.calc(vals), nil
+}
+
+// CalcDecimal calculates TEMA of provided period values.
+// It will slice out only needed values (period and offset
+// are used to calc boundaries).
+// Returns TEMA calculation result and optionally an error.
+func (t *tema) CalcDecimal(dd []decimal.Decimal) (decimal.Decimal, error) {
+	start := t.CandlesCount()
+	end := t.offset
+	if dd == nil || len(dd) < start {
+		return decimal.Zero, errors.New("TEMA values list is too small")
+	}
+
+	return t.calc(dd[len(dd)-start : len(dd)-end]), nil
+}
+
+// calc calculates TEMA of provided values.
+// TEMA calculation:
+// 1. EMA1 = EMA of values;
+// 2. EMA2 = EMA of EMA1 values;
+// 3. EMA3 = EMA of EMA2 values;
+// 4. TEMA = 3 x EMA1 - 3 x EMA2 + EMA3;
+func (t *tema) calc(vals []decimal.Decimal) decimal.Decimal {
+	ema1 := ema.Series(vals, t.period)
+	ema2 := ema.Series(ema1, t.period)
+	ema3 := ema.Series(ema2, t.period)
+
+	three := decimal.New(3, 0)
+	return ema1[len(ema1)-1].Mul(three).Sub(ema2[len(ema2)-1].Mul(three)).Add(ema3[len(ema3)-1])
+}
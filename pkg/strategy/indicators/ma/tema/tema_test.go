@@ -0,0 +1,234 @@
+package tema
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTEMACandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		TEMA   *tema
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			TEMA: func() *tema {
+				val, _ := New(2, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Result: 10,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.TEMA.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestTEMANew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Price       string
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful TEMA creation when period is invalid",
+			Period:      0,
+			Price:       exchange.ClosePrice,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful TEMA creation when price type is invalid",
+			Period:      1,
+			Price:       "test",
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful TEMA creation",
+			Period:      1,
+			Price:       exchange.ClosePrice,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, 1, v.Price)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestTEMACalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		TEMA        *tema
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful TEMA calc when candles list is too small",
+			TEMA: func() *tema {
+				val, _ := New(2, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful TEMA calc of 2 candles",
+			TEMA: func() *tema {
+				val, _ := New(2, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(12, 0)},
+				{Close: decimal.New(11, 0)},
+				{Close: decimal.New(14, 0)},
+				{Close: decimal.New(13, 0)},
+				{Close: decimal.New(15, 0)},
+				{Close: decimal.New(17, 0)},
+				{Close: decimal.New(16, 0)},
+				{Close: decimal.New(18, 0)},
+				{Close: decimal.New(20, 0)},
+				{Close: decimal.New(19, 0)},
+				{Close: decimal.New(21, 0)},
+			},
+			Result: decimal.RequireFromString("20.9040"),
+		},
+		{
+			Name: "Successful TEMA calc of 2 candles with offset set to 2",
+			TEMA: func() *tema {
+				val, _ := New(2, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(12, 0)},
+				{Close: decimal.New(11, 0)},
+				{Close: decimal.New(14, 0)},
+				{Close: decimal.New(13, 0)},
+				{Close: decimal.New(15, 0)},
+				{Close: decimal.New(17, 0)},
+				{Close: decimal.New(16, 0)},
+				{Close: decimal.New(18, 0)},
+				{Close: decimal.New(20, 0)},
+				{Close: decimal.New(19, 0)},
+				{Close: decimal.New(21, 0)},
+			},
+			Result: decimal.RequireFromString("19.9729"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.TEMA.Calc(v.Candles)
+			if !res.Round(4).Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestTEMACalcDecimal(t *testing.T) {
+	tests := []struct {
+		Name        string
+		TEMA        *tema
+		Values      []decimal.Decimal
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful TEMA calc when values list is too small",
+			TEMA: func() *tema {
+				val, _ := New(2, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(12, 0),
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful TEMA calc of 2 values",
+			TEMA: func() *tema {
+				val, _ := New(2, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Values: []decimal.Decimal{
+				decimal.New(10, 0),
+				decimal.New(12, 0),
+				decimal.New(11, 0),
+				decimal.New(14, 0),
+				decimal.New(13, 0),
+				decimal.New(15, 0),
+				decimal.New(17, 0),
+				decimal.New(16, 0),
+				decimal.New(18, 0),
+				decimal.New(20, 0),
+				decimal.New(19, 0),
+				decimal.New(21, 0),
+			},
+			Result: decimal.RequireFromString("20.9040"),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.TEMA.CalcDecimal(v.Values)
+			if !res.Round(4).Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Package vwma implements VWMA (Volume Weighted Moving Average)
+// indicator calculation logic.
+package vwma
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrVolumeRequired is returned when VWMA is
+	// calculated from values without volumes.
+	ErrVolumeRequired = errors.New("VWMA cannot be calculated without volume")
+)
+
+type VWMA interface{ ma.MA }
+
+// vwma contains internal data values needed
+// to calculate VWMA.
+type vwma struct {
+	period int
+	offset int
+	price  string
+}
+
+// New creates new vwma object with provided data values
+// to make further calculations.
+func New(period, offset int, price string) (*vwma, error) {
+	if period <= 0 {
+		return nil, errors.New("VWMA period must be positive")
+	}
+
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	return &vwma{
+		period: period,
+		offset: offset,
+		price:  price,
+	}, nil
+}
+
+// CandlesCount returns min candle count needed
+// to calculate VWMA with the provided period.
+func (v *vwma) CandlesCount() int {
+	return v.period + v.offset
+}
+
+// Calc calculates VWMA of provided period candles.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns VWMA calculation result and optionally an error.
+// VWMA calculation:
+// 1. VWMA = Sum(price x base volume) / Sum(base volume);
+// If volume of all candles is zero, SMA is returned.
+func (v *vwma) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := v.CandlesCount()
+	end := v.offset
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("VWMA candles list is too small")
+	}
+
+	totalVal := decimal.Zero
+	totalVol := decimal.Zero
+	totalPrice := decimal.Zero
+	for _, c := range cc[len(cc)-start : len(cc)-end] {
+		totalVal = totalVal.Add(c.Price(v.price).Mul(c.BaseVolume))
+		totalVol = totalVol.Add(c.BaseVolume)
+		totalPrice = totalPrice.Add(c.Price(v.price))
+	}
+
+	if totalVol.IsZero() {
+		return totalPrice.Div(decimal.New(int64(v.period), 0)), nil
+	}
+
+	return totalVal.Div(totalVol), nil
+}
+
+// CalcDecimal always returns an error, because
+// VWMA requires volume of each value.
+func (v *vwma) CalcDecimal(dd []decimal.Decimal) (decimal.Decimal, error) {
+	return decimal.Zero, ErrVolumeRequired
+}
@@ -0,0 +1,180 @@
+package vwma
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func candle(close, volume int64) exchange.Candle {
+	return exchange.Candle{
+		Close:      decimal.New(close, 0),
+		BaseVolume: decimal.New(volume, 0),
+	}
+}
+
+func TestVWMACandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		VWMA   *vwma
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			VWMA: func() *vwma {
+				val, _ := New(3, 2, exchange.ClosePrice)
+				return val
+			}(),
+			Result: 5,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.VWMA.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestVWMANew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Price       string
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful VWMA creation when period is invalid",
+			Period:      0,
+			Price:       exchange.ClosePrice,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful VWMA creation when price type is invalid",
+			Period:      1,
+			Price:       "test",
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful VWMA creation",
+			Period:      1,
+			Price:       exchange.ClosePrice,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, 1, v.Price)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestVWMACalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		VWMA        *vwma
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful VWMA calc of 3 candles when only 2 are provided",
+			VWMA: func() *vwma {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 1),
+				candle(20, 1),
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful VWMA calc of 3 candles",
+			VWMA: func() *vwma {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 1),
+				candle(20, 2),
+				candle(30, 5),
+			},
+			Result: decimal.New(25, 0),
+		},
+		{
+			Name: "Successful VWMA calc of 3 candles when volume is zero",
+			VWMA: func() *vwma {
+				val, _ := New(3, 0, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 0),
+				candle(20, 0),
+				candle(30, 0),
+			},
+			Result: decimal.New(20, 0),
+		},
+		{
+			Name: "Successful VWMA calc of 2 candles with offset set to 1",
+			VWMA: func() *vwma {
+				val, _ := New(2, 1, exchange.ClosePrice)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 1),
+				candle(20, 3),
+				candle(40, 1),
+				candle(100, 100),
+			},
+			Result: decimal.New(25, 0),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.VWMA.Calc(v.Candles)
+			if !res.Equal(v.Result) {
+				t.Errorf("incorrect result, \nexpected: %s, \ngot: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestVWMACalcDecimal(t *testing.T) {
+	val, _ := New(1, 0, exchange.ClosePrice)
+	_, err := val.CalcDecimal([]decimal.Decimal{decimal.New(1, 0)})
+	if err != ErrVolumeRequired {
+		t.Errorf("incorrect error, expected: %v, got: %v", ErrVolumeRequired, err)
+	}
+}
@@ -31,6 +31,26 @@ func TestNewMA(t *testing.T) {
 			Name:   "Successful WMA creation",
 			MAType: ma.WMAName,
 		},
+		{
+			Name:   "Successful DEMA creation",
+			MAType: ma.DEMAName,
+		},
+		{
+			Name:   "Successful TEMA creation",
+			MAType: ma.TEMAName,
+		},
+		{
+			Name:   "Successful HMA creation",
+			MAType: ma.HMAName,
+		},
+		{
+			Name:   "Successful KAMA creation",
+			MAType: ma.KAMAName,
+		},
+		{
+			Name:   "Successful VWMA creation",
+			MAType: ma.VWMAName,
+		},
 	}
 
 	for _, v := range tests {
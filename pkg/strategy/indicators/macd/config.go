@@ -3,6 +3,7 @@ package macd
 import (
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
 )
 
 // MACDConfig contains settings needed
@@ -23,6 +24,10 @@ type MACDConfig struct {
 	// Price specifies which candle price (Open, High, Low, Close)
 	// should be used when calculating MACD.
 	Price string `json:"price" conform:"trim,lower"`
+
+	// SignalType specifies which MA should be used to
+	// calculate SignalLine. EMA is used when empty.
+	SignalType string `json:"signalType" conform:"trim,lower"`
 }
 
 // validate checks if MACDConfig values
@@ -44,5 +49,19 @@ func (m *MACDConfig) Validate() error {
 		return err
 	}
 
+	if m.SignalType == "" {
+		return nil
+	}
+
+	if err := ma.MATypeValidation(m.SignalType); err != nil {
+		return err
+	}
+
+	// signal line is calculated from MACD values,
+	// which have no volume.
+	if m.SignalType == ma.VWMAName {
+		return errors.New("VWMA cannot be used to calculate signal line")
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package macd
 
 import (
 	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
 	"testing"
 )
 
@@ -31,6 +32,21 @@ func TestMACDConfigValidation(t *testing.T) {
 			Config:      MACDConfig{EMA1Period: 10, EMA2Period: 10, SignalPeriod: 100, Price: "test"},
 			ShouldError: true,
 		},
+		{
+			Name:        "Unsuccessful validation when signal type is invalid",
+			Config:      MACDConfig{EMA1Period: 10, EMA2Period: 10, SignalPeriod: 100, Price: exchange.ClosePrice, SignalType: "test"},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when signal type is VWMA",
+			Config:      MACDConfig{EMA1Period: 10, EMA2Period: 10, SignalPeriod: 100, Price: exchange.ClosePrice, SignalType: ma.VWMAName},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation with signal type",
+			Config:      MACDConfig{EMA1Period: 10, EMA2Period: 10, SignalPeriod: 100, Price: exchange.ClosePrice, SignalType: ma.HMAName},
+			ShouldError: false,
+		},
 		{
 			Name:        "Successful validation",
 			Config:      MACDConfig{EMA1Period: 10, EMA2Period: 10, SignalPeriod: 100, Price: exchange.ClosePrice},
@@ -3,6 +3,8 @@ package macd
 
 import (
 	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators"
+	"eonbot/pkg/strategy/indicators/ma"
 	"eonbot/pkg/strategy/indicators/ma/ema"
 	"errors"
 
@@ -14,13 +16,13 @@ type MACD interface {
 	Calc(cc []exchange.Candle) (MACDInfo, error)
 }
 
-// MACD contains ema1, ema2, signal EMAs and
+// MACD contains ema1, ema2 EMAs, signal MA and
 // offset values needed to calculate MACDInfo.
 type macd struct {
-	offset    int
-	ema1      ema.EMA
-	ema2      ema.EMA
-	signalEMA ema.EMA
+	offset   int
+	ema1     ema.EMA
+	ema2     ema.EMA
+	signalMA ma.MA
 }
 
 // MACDInfo contains data calculated
@@ -33,7 +35,9 @@ type MACDInfo struct {
 
 // New creates new MACD object with provided period
 // and offset to make further calculations.
-func New(ema1, ema2, signal, offset int, price string) (*macd, error) {
+// If signalType is empty, EMA is used to
+// calculate signal line.
+func New(ema1, ema2, signal, offset int, price, signalType string) (*macd, error) {
 	// NOTE: offset is used in Calc, so it's not needed
 	// during EMAs creation.
 
@@ -47,7 +51,11 @@ func New(ema1, ema2, signal, offset int, price string) (*macd, error) {
 		return nil, err
 	}
 
-	signalEMA, err := ema.New(signal, 0, price)
+	if signalType == "" {
+		signalType = ma.EMAName
+	}
+
+	signalMA, err := indicators.NewMA(signalType, price, signal, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -55,23 +63,23 @@ func New(ema1, ema2, signal, offset int, price string) (*macd, error) {
 	return newMACD(
 		ema1Indicator,
 		ema2Indicator,
-		signalEMA,
+		signalMA,
 		offset), nil
 }
 
 // NewFromConfig creates new MACD object the same way as New,
 // it just takes values from provided MACDConfig.
 func NewFromConfig(conf MACDConfig, offset int) (*macd, error) {
-	return New(conf.EMA1Period, conf.EMA2Period, conf.SignalPeriod, offset, conf.Price)
+	return New(conf.EMA1Period, conf.EMA2Period, conf.SignalPeriod, offset, conf.Price, conf.SignalType)
 }
 
 // newMACD creates new MACD object with specfied data values.
-func newMACD(ema1, ema2, signalEMA ema.EMA, offset int) *macd {
+func newMACD(ema1, ema2 ema.EMA, signalMA ma.MA, offset int) *macd {
 	return &macd{
-		ema1:      ema1,
-		ema2:      ema2,
-		signalEMA: signalEMA,
-		offset:    offset,
+		ema1:     ema1,
+		ema2:     ema2,
+		signalMA: signalMA,
+		offset:   offset,
 	}
 }
 
@@ -83,7 +91,7 @@ func (m *macd) CandlesCount() (count int) {
 	} else {
 		count = m.ema2.CandlesCount()
 	}
-	count += m.signalEMA.CandlesCount() + m.offset
+	count += m.signalMA.CandlesCount() + m.offset
 	return count
 }
 
@@ -109,7 +117,7 @@ func (m *macd) Calc(cc []exchange.Candle) (MACDInfo, error) {
 	candles := cc[len(cc)-start : len(cc)-end]
 
 	var macd []decimal.Decimal
-	sigInit := m.signalEMA.CandlesCount() - 1
+	sigInit := m.signalMA.CandlesCount() - 1
 	for i := 0; i <= sigInit; i++ {
 		ema1Res, err := m.ema1.Calc(candles[i : len(candles)-(sigInit-i)])
 		if err != nil {
@@ -131,7 +139,7 @@ func (m *macd) Calc(cc []exchange.Candle) (MACDInfo, error) {
 		macd = append(macd, macdVal)
 	}
 
-	lastSignal, err := m.signalEMA.CalcDecimal(macd)
+	lastSignal, err := m.signalMA.CalcDecimal(macd)
 	if err != nil {
 		return MACDInfo{}, err
 	}
@@ -3,6 +3,7 @@ package macd
 import (
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/indicators/all_mocks/ema_mock"
+	"eonbot/pkg/strategy/indicators/ma"
 	"eonbot/pkg/strategy/indicators/ma/ema"
 	"errors"
 	"testing"
@@ -19,7 +20,7 @@ func TestMACDCandlesCount(t *testing.T) {
 		{
 			Name: "Successful count return",
 			MACD: func() *macd {
-				val, _ := New(3, 4, 2, 2, exchange.ClosePrice)
+				val, _ := New(3, 4, 2, 2, exchange.ClosePrice, "")
 				return val
 			}(),
 			Result: 14,
@@ -61,7 +62,7 @@ func TestMACDCalc(t *testing.T) {
 		{
 			Name: "Unsuccessful MACD calculation of 12 candles when nil provided",
 			MACD: func() *macd {
-				val, _ := New(3, 4, 2, 0, exchange.ClosePrice)
+				val, _ := New(3, 4, 2, 0, exchange.ClosePrice, "")
 				return val
 			}(),
 			Candles:     nil,
@@ -70,7 +71,7 @@ func TestMACDCalc(t *testing.T) {
 		{
 			Name: "Successful calculation of 12 candles",
 			MACD: func() *macd {
-				val, _ := New(3, 4, 2, 0, exchange.ClosePrice)
+				val, _ := New(3, 4, 2, 0, exchange.ClosePrice, "")
 				return val
 			}(),
 			Candles: []exchange.Candle{
@@ -93,10 +94,42 @@ func TestMACDCalc(t *testing.T) {
 				Histogram:  decimal.RequireFromString("-0.0098"),
 			},
 		},
+		{
+			Name: "Successful calculation of 12 candles with SMA signal line",
+			MACD: func() *macd {
+				val, _ := NewFromConfig(MACDConfig{
+					EMA1Period:   3,
+					EMA2Period:   4,
+					SignalPeriod: 2,
+					Price:        exchange.ClosePrice,
+					SignalType:   ma.SMAName,
+				}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.RequireFromString("10")},
+				{Close: decimal.RequireFromString("11")},
+				{Close: decimal.RequireFromString("12")},
+				{Close: decimal.RequireFromString("13")},
+				{Close: decimal.RequireFromString("14")},
+				{Close: decimal.RequireFromString("15")},
+				{Close: decimal.RequireFromString("10")},
+				{Close: decimal.RequireFromString("11")},
+				{Close: decimal.RequireFromString("12")},
+				{Close: decimal.RequireFromString("13")},
+				{Close: decimal.RequireFromString("14")}, // 13.25 (EMA1) - 13.0832 (EMA2) = 0.1668
+				{Close: decimal.RequireFromString("15")}, // 14 (EMA1) - 13.8888 (EMA2) = 0.1112
+			},
+			Result: MACDInfo{
+				MACDLine:   decimal.RequireFromString("0.1112"),
+				SignalLine: decimal.RequireFromString("0.139"),
+				Histogram:  decimal.RequireFromString("-0.0278"),
+			},
+		},
 		{
 			Name: "Successful calculation of 12 candles with offset set to 1",
 			MACD: func() *macd {
-				val, _ := New(3, 4, 2, 1, exchange.LowPrice)
+				val, _ := New(3, 4, 2, 1, exchange.LowPrice, "")
 				return val
 			}(),
 			Candles: []exchange.Candle{
@@ -123,7 +156,7 @@ func TestMACDCalc(t *testing.T) {
 		{
 			Name: "Successful calculation of 12 candles with EMA2 being the fast one",
 			MACD: func() *macd {
-				val, _ := New(4, 3, 2, 0, exchange.HighPrice)
+				val, _ := New(4, 3, 2, 0, exchange.HighPrice, "")
 				return val
 			}(),
 			Candles: []exchange.Candle{
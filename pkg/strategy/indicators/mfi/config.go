@@ -0,0 +1,23 @@
+package mfi
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// MFIConfig contains settings needed
+// to calculate MFI.
+type MFIConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate MFI.
+	Period int `json:"period"`
+}
+
+// validate checks if MFIConfig values
+// are valid and usable.
+func (m *MFIConfig) Validate() error {
+	if err := ma.PeriodValidation(m.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
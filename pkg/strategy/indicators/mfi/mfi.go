@@ -0,0 +1,94 @@
+// Package mfi implements MFI (Money Flow Index) indicator calculation logic.
+package mfi
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/utils"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type MFI interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+// mfi contains internal data values needed
+// to calculate MFI.
+type mfi struct {
+	period int
+	offset int
+}
+
+// New creates new MFI object with provided period
+// and offset to make further calculations.
+func New(period, offset int) (*mfi, error) {
+	if period <= 0 {
+		return nil, errors.New("MFI period must be positive")
+	}
+
+	return &mfi{
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new MFI object the same way as New,
+// it just takes values from provided MFIConfig.
+func NewFromConfig(conf MFIConfig, offset int) (*mfi, error) {
+	return New(conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate MFI with the provided period.
+func (m *mfi) CandlesCount() int {
+	return m.period + 1 + m.offset
+}
+
+// Calc calculates MFI of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns MFI calculation result and optionally an error.
+// MFI calculation:
+//  1. Typical price = (high + low + close) / 3;
+//  2. Raw money flow = typical price x base volume;
+//  3. If typical price is above previous typical price, raw money flow
+//     is positive, if below - negative;
+//  4. Money flow ratio = Sum(positive flows) / Sum(negative flows);
+//  5. MFI = 100 - 100 / (1 + money flow ratio);
+func (m *mfi) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := m.CandlesCount()
+	end := m.offset
+
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("MFI candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	posFlow := decimal.Zero
+	negFlow := decimal.Zero
+	for i := 1; i < len(candles); i++ {
+		tp := candles[i].TypicalPrice()
+		prevTP := candles[i-1].TypicalPrice()
+		flow := tp.Mul(candles[i].BaseVolume)
+
+		switch tp.Cmp(prevTP) {
+		case 1:
+			posFlow = posFlow.Add(flow)
+		case -1:
+			negFlow = negFlow.Add(flow)
+		}
+	}
+
+	if negFlow.IsZero() {
+		if posFlow.IsZero() {
+			return decimal.New(50, 0), nil
+		}
+		return decimal.New(100, 0), nil
+	}
+
+	ratio := posFlow.Div(negFlow)
+	return decimal.New(100, 0).Sub(decimal.New(100, 0).Div(utils.PreventZero(decimal.New(1, 0).Add(ratio)))), nil
+}
@@ -0,0 +1,159 @@
+package mfi
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMFICandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		MFI    *mfi
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			MFI: func() *mfi {
+				val, _ := New(3, 2)
+				return val
+			}(),
+			Result: 6,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.MFI.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestMFINew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful MFI creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful MFI creation",
+			Period:      1,
+			Offset:      1,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestMFICalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		MFI         *mfi
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles MFI when only 2 provided",
+			MFI: func() *mfi {
+				val, _ := NewFromConfig(MFIConfig{Period: 5}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(4, 0)},
+				{Close: decimal.New(3, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 2 candles MFI",
+			MFI: func() *mfi {
+				val, _ := New(2, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(10, 0), Low: decimal.New(10, 0), Close: decimal.New(10, 0), BaseVolume: decimal.New(7, 0)},
+				{High: decimal.New(12, 0), Low: decimal.New(12, 0), Close: decimal.New(12, 0), BaseVolume: decimal.New(2, 0)},
+				{High: decimal.New(11, 0), Low: decimal.New(11, 0), Close: decimal.New(11, 0), BaseVolume: decimal.New(4, 0)},
+			},
+			Result: decimal.RequireFromString("35.29"),
+		},
+		{
+			Name: "Successfully calculated 2 candles MFI when there is no negative flow",
+			MFI: func() *mfi {
+				val, _ := New(2, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(10, 0), Low: decimal.New(10, 0), Close: decimal.New(10, 0), BaseVolume: decimal.New(7, 0)},
+				{High: decimal.New(12, 0), Low: decimal.New(12, 0), Close: decimal.New(12, 0), BaseVolume: decimal.New(2, 0)},
+				{High: decimal.New(13, 0), Low: decimal.New(13, 0), Close: decimal.New(13, 0), BaseVolume: decimal.New(4, 0)},
+				{High: decimal.New(1, 0), Low: decimal.New(1, 0), Close: decimal.New(1, 0), BaseVolume: decimal.New(4, 0)},
+			},
+			Result: decimal.New(100, 0),
+		},
+		{
+			Name: "Successfully calculated 2 candles MFI when there is no flow",
+			MFI: func() *mfi {
+				val, _ := New(2, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(10, 0)},
+				{Close: decimal.New(10, 0)},
+			},
+			Result: decimal.New(50, 0),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.MFI.Calc(v.Candles)
+			if !v.Result.Equal(res.Round(2)) {
+				t.Errorf("incorrect result; expected: %s, got: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
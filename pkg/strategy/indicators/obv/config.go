@@ -0,0 +1,32 @@
+package obv
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// OBVConfig contains settings needed
+// to calculate OBV.
+type OBVConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate OBV.
+	Period int `json:"period"`
+
+	// Volume specifies which candle volume (base, counter)
+	// should be used when calculating OBV.
+	Volume string `json:"volume" conform:"trim,lower"`
+}
+
+// validate checks if OBVConfig values
+// are valid and usable.
+func (o *OBVConfig) Validate() error {
+	if err := ma.PeriodValidation(o.Period); err != nil {
+		return err
+	}
+
+	if err := exchange.CandleVolumeValid(o.Volume); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package obv
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+)
+
+func TestOBVConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      OBVConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      OBVConfig{Period: 300, Volume: exchange.BaseVolume},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when volume is invalid",
+			Config:      OBVConfig{Period: 10, Volume: "test"},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      OBVConfig{Period: 10, Volume: exchange.BaseVolume},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Package obv implements OBV (On-Balance Volume) indicator calculation logic.
+package obv
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type OBV interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+// obv contains internal data values needed
+// to calculate OBV.
+type obv struct {
+	period int
+	offset int
+	volume string
+}
+
+// New creates new OBV object with provided period,
+// offset and volume type to make further calculations.
+func New(period, offset int, volume string) (*obv, error) {
+	if err := exchange.CandleVolumeValid(volume); err != nil {
+		return nil, err
+	}
+
+	if period <= 0 {
+		return nil, errors.New("OBV period must be positive")
+	}
+
+	return &obv{
+		period: period,
+		offset: offset,
+		volume: volume,
+	}, nil
+}
+
+// NewFromConfig creates new OBV object the same way as New,
+// it just takes values from provided OBVConfig.
+func NewFromConfig(conf OBVConfig, offset int) (*obv, error) {
+	return New(conf.Period, offset, conf.Volume)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate OBV with the provided period.
+func (o *obv) CandlesCount() int {
+	return o.period + 1 + o.offset
+}
+
+// Calc calculates OBV of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns OBV calculation result and optionally an error.
+// OBV calculation (OBV starts at zero at the beginning of the period):
+//  1. If current close > previous close:
+//     OBV = previous OBV + current volume;
+//  2. If current close < previous close:
+//     OBV = previous OBV - current volume;
+//  3. If current close = previous close:
+//     OBV = previous OBV;
+func (o *obv) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := o.CandlesCount()
+	end := o.offset
+
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("OBV candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	res := decimal.Zero
+	for i := 1; i < len(candles); i++ {
+		switch candles[i].Close.Cmp(candles[i-1].Close) {
+		case 1:
+			res = res.Add(candles[i].Volume(o.volume))
+		case -1:
+			res = res.Sub(candles[i].Volume(o.volume))
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,157 @@
+package obv
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestOBVCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		OBV    *obv
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			OBV: func() *obv {
+				val, _ := New(3, 2, exchange.BaseVolume)
+				return val
+			}(),
+			Result: 6,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.OBV.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestOBVNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		Volume      string
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful OBV creation when volume type is invalid",
+			Period:      1,
+			Offset:      1,
+			Volume:      "test",
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful OBV creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			Volume:      exchange.BaseVolume,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful OBV creation",
+			Period:      1,
+			Offset:      1,
+			Volume:      exchange.BaseVolume,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset, v.Volume)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestOBVCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		OBV         *obv
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles OBV when only 2 provided",
+			OBV: func() *obv {
+				val, _ := NewFromConfig(OBVConfig{Period: 5, Volume: exchange.BaseVolume}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(4, 0)},
+				{Close: decimal.New(3, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 2 candles OBV",
+			OBV: func() *obv {
+				val, _ := New(2, 0, exchange.BaseVolume)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(1, 0), BaseVolume: decimal.New(10, 0)},
+				{Close: decimal.New(2, 0), BaseVolume: decimal.New(20, 0)},
+				{Close: decimal.New(1, 0), BaseVolume: decimal.New(5, 0)},
+			},
+			Result: decimal.New(15, 0),
+		},
+		{
+			Name: "Successfully calculated 2 candles OBV with counter volume and offset set to 1",
+			OBV: func() *obv {
+				val, _ := New(2, 1, exchange.CounterVolume)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(1, 0), CounterVolume: decimal.New(1, 0)},
+				{Close: decimal.New(3, 0), CounterVolume: decimal.New(4, 0)},
+				{Close: decimal.New(2, 0), CounterVolume: decimal.New(3, 0)},
+				{Close: decimal.New(2, 0), CounterVolume: decimal.New(8, 0)},
+				{Close: decimal.New(5, 0), CounterVolume: decimal.New(100, 0)},
+			},
+			Result: decimal.New(-3, 0),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.OBV.Calc(v.Candles)
+			if !v.Result.Equal(res) {
+				t.Errorf("incorrect result; expected: %s, got: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package pivot
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// PivotConfig contains settings needed
+// to calculate pivot points.
+type PivotConfig struct {
+	// Method specifies how levels should be calculated:
+	// classic, fibonacci or camarilla.
+	Method string `json:"method" conform:"trim,lower"`
+
+	// Period specifies how many candles should be merged
+	// into a single higher-interval candle.
+	Period int `json:"period"`
+}
+
+// validate checks if PivotConfig values
+// are valid and usable.
+func (p *PivotConfig) Validate() error {
+	if err := MethodValidation(p.Method); err != nil {
+		return err
+	}
+
+	if err := ma.PeriodValidation(p.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
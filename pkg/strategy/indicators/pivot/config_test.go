@@ -0,0 +1,44 @@
+package pivot
+
+import "testing"
+
+func TestPivotConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      PivotConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when method is invalid",
+			Config:      PivotConfig{Method: "test", Period: 24},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      PivotConfig{Method: ClassicName, Period: 300},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      PivotConfig{Method: FibonacciName, Period: 24},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Package pivot implements pivot points (classic, Fibonacci
+// and Camarilla) calculation logic.
+package pivot
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ClassicName   = "classic"
+	FibonacciName = "fibonacci"
+	CamarillaName = "camarilla"
+)
+
+type Pivot interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (PivotInfo, error)
+}
+
+// pivot contains internal data values
+// needed to calculate PivotInfo values.
+type pivot struct {
+	method string
+	period int
+
+	// offset specifies how many completed
+	// sessions should be skipped.
+	offset int
+}
+
+// PivotInfo contains result values of
+// pivot.Calc function. R4 and S4 are only
+// calculated with Camarilla method.
+type PivotInfo struct {
+	Pivot decimal.Decimal `json:"pivot"`
+	R1    decimal.Decimal `json:"r1"`
+	R2    decimal.Decimal `json:"r2"`
+	R3    decimal.Decimal `json:"r3"`
+	R4    decimal.Decimal `json:"r4"`
+	S1    decimal.Decimal `json:"s1"`
+	S2    decimal.Decimal `json:"s2"`
+	S3    decimal.Decimal `json:"s3"`
+	S4    decimal.Decimal `json:"s4"`
+}
+
+// New creates new pivot object with provided method,
+// period and offset (in completed sessions) to make
+// further calculations.
+func New(method string, period, offset int) (*pivot, error) {
+	if err := MethodValidation(method); err != nil {
+		return nil, err
+	}
+
+	if period <= 0 {
+		return nil, errors.New("pivot points period must be positive")
+	}
+
+	if offset < 0 {
+		return nil, errors.New("pivot points offset cannot be negative")
+	}
+
+	return &pivot{
+		method: method,
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new pivot object the same way as New,
+// it just takes values from provided PivotConfig.
+func NewFromConfig(conf PivotConfig, offset int) (*pivot, error) {
+	return New(conf.Method, conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed to calculate
+// pivot points with the provided period and offset: the
+// current (not completed) session and all completed sessions
+// up until the one used for calculations.
+func (p *pivot) CandlesCount() int {
+	return p.period * (p.offset + 2)
+}
+
+// Calc calculates pivot points of the last completed session
+// (skipping offset sessions). Session is a higher-interval
+// candle formed by merging period candles (e.g. 24 1h candles
+// form a daily candle), sessions are aligned to the higher
+// interval boundaries (e.g. daily sessions start at 00:00 UTC),
+// so the levels stay the same during the whole session.
+// Candles interval is determined from candles timestamps.
+// Returns pivot points calculation result and optionally an error.
+// Pivot points calculation (H, L and C are merged candle's
+// high, low and close, R = H - L):
+// 1. Pivot = (H + L + C) / 3;
+// 2. Classic: R1 = 2P - L, S1 = 2P - H, R2 = P + R, S2 = P - R,
+// R3 = H + 2(P - L), S3 = L - 2(H - P);
+// 3. Fibonacci: R1/S1 = P +/- 0.382R, R2/S2 = P +/- 0.618R,
+// R3/S3 = P +/- R;
+// 4. Camarilla: R1/S1 = C +/- 1.1R/12, R2/S2 = C +/- 1.1R/6,
+// R3/S3 = C +/- 1.1R/4, R4/S4 = C +/- 1.1R/2;
+func (p *pivot) Calc(cc []exchange.Candle) (PivotInfo, error) {
+	candles, err := p.session(cc)
+	if err != nil {
+		return PivotInfo{}, err
+	}
+
+	high := candles[0].High
+	low := candles[0].Low
+	for _, c := range candles[1:] {
+		if c.High.GreaterThan(high) {
+			high = c.High
+		}
+
+		if c.Low.LessThan(low) {
+			low = c.Low
+		}
+	}
+	closePrice := candles[len(candles)-1].Close
+
+	pp := high.Add(low).Add(closePrice).Div(decimal.New(3, 0))
+	rng := high.Sub(low)
+
+	switch p.method {
+	case FibonacciName:
+		return PivotInfo{
+			Pivot: pp,
+			R1:    pp.Add(rng.Mul(decimal.RequireFromString("0.382"))),
+			R2:    pp.Add(rng.Mul(decimal.RequireFromString("0.618"))),
+			R3:    pp.Add(rng),
+			S1:    pp.Sub(rng.Mul(decimal.RequireFromString("0.382"))),
+			S2:    pp.Sub(rng.Mul(decimal.RequireFromString("0.618"))),
+			S3:    pp.Sub(rng),
+		}, nil
+	case CamarillaName:
+		rng = rng.Mul(decimal.RequireFromString("1.1"))
+		return PivotInfo{
+			Pivot: pp,
+			R1:    closePrice.Add(rng.Div(decimal.New(12, 0))),
+			R2:    closePrice.Add(rng.Div(decimal.New(6, 0))),
+			R3:    closePrice.Add(rng.Div(decimal.New(4, 0))),
+			R4:    closePrice.Add(rng.Div(decimal.New(2, 0))),
+			S1:    closePrice.Sub(rng.Div(decimal.New(12, 0))),
+			S2:    closePrice.Sub(rng.Div(decimal.New(6, 0))),
+			S3:    closePrice.Sub(rng.Div(decimal.New(4, 0))),
+			S4:    closePrice.Sub(rng.Div(decimal.New(2, 0))),
+		}, nil
+	default:
+		two := decimal.New(2, 0)
+		return PivotInfo{
+			Pivot: pp,
+			R1:    pp.Mul(two).Sub(low),
+			R2:    pp.Add(rng),
+			R3:    high.Add(pp.Sub(low).Mul(two)),
+			S1:    pp.Mul(two).Sub(high),
+			S2:    pp.Sub(rng),
+			S3:    low.Sub(high.Sub(pp).Mul(two)),
+		}, nil
+	}
+}
+
+// session returns candles of the completed session
+// which should be used for calculations.
+func (p *pivot) session(cc []exchange.Candle) ([]exchange.Candle, error) {
+	if len(cc) < 2 {
+		return nil, errors.New("pivot points candles list is too small")
+	}
+
+	// the smallest gap between candles is used as
+	// candles interval, so that missing candles
+	// wouldn't affect it.
+	var interval time.Duration
+	for i := 1; i < len(cc); i++ {
+		gap := cc[i].Timestamp.Sub(cc[i-1].Timestamp)
+		if gap > 0 && (interval == 0 || gap < interval) {
+			interval = gap
+		}
+	}
+
+	if interval == 0 {
+		return nil, errors.New("pivot points candles interval cannot be determined")
+	}
+
+	span := interval * time.Duration(p.period)
+	current := cc[len(cc)-1].Timestamp.UTC().Truncate(span)
+	end := current.Add(-span * time.Duration(p.offset))
+	start := end.Add(-span)
+
+	if cc[0].Timestamp.After(start) {
+		return nil, errors.New("pivot points candles list is too small")
+	}
+
+	candles := make([]exchange.Candle, 0, p.period)
+	for _, c := range cc {
+		if !c.Timestamp.Before(start) && c.Timestamp.Before(end) {
+			candles = append(candles, c)
+		}
+	}
+
+	if len(candles) == 0 {
+		return nil, errors.New("pivot points session candles are missing")
+	}
+
+	return candles, nil
+}
+
+// MethodValidation checks if provided pivot
+// points calculation method is valid.
+func MethodValidation(method string) error {
+	switch method {
+	case ClassicName, FibonacciName, CamarillaName:
+		return nil
+	default:
+		return errors.New("pivot points method is invalid")
+	}
+}
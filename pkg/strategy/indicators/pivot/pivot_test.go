@@ -0,0 +1,196 @@
+package pivot
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPivotCandlesCount(t *testing.T) {
+	val, _ := New(ClassicName, 3, 2)
+	assert.Equal(t, 12, val.CandlesCount())
+}
+
+func TestPivotNew(t *testing.T) {
+	_, err := New("test", 1, 1)
+	assert.NotNil(t, err)
+
+	_, err = New(ClassicName, 0, 1)
+	assert.NotNil(t, err)
+
+	_, err = New(ClassicName, 1, -1)
+	assert.NotNil(t, err)
+
+	_, err = New(CamarillaName, 1, 1)
+	assert.Nil(t, err)
+}
+
+func TestPivotCalc(t *testing.T) {
+	day := time.Date(2019, 1, 2, 0, 0, 0, 0, time.UTC)
+	candles := []exchange.Candle{
+		// incomplete 21:00 - 00:00 session.
+		{Timestamp: day.Add(-2 * time.Hour), High: decimal.New(100, 0), Low: decimal.New(1, 0), Close: decimal.New(50, 0)},
+
+		// completed 00:00 - 03:00 session.
+		{Timestamp: day, High: decimal.New(12, 0), Low: decimal.New(8, 0), Close: decimal.New(10, 0)},
+		{Timestamp: day.Add(time.Hour), High: decimal.New(14, 0), Low: decimal.New(9, 0), Close: decimal.New(13, 0)},
+		{Timestamp: day.Add(2 * time.Hour), High: decimal.New(13, 0), Low: decimal.New(10, 0), Close: decimal.New(11, 0)},
+
+		// live 03:00 - 06:00 session.
+		{Timestamp: day.Add(3 * time.Hour), High: decimal.New(50, 0), Low: decimal.New(2, 0), Close: decimal.New(40, 0)},
+		{Timestamp: day.Add(4 * time.Hour), High: decimal.New(200, 0), Low: decimal.New(1, 0), Close: decimal.New(60, 0)},
+	}
+
+	tests := []struct {
+		Name        string
+		Pivot       *pivot
+		Candles     []exchange.Candle
+		Result      PivotInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles pivot points when only 2 provided",
+			Pivot: func() *pivot {
+				val, _ := NewFromConfig(PivotConfig{Method: ClassicName, Period: 5}, 0)
+				return val
+			}(),
+			Candles:     candles[:2],
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful calculation when candles interval cannot be determined",
+			Pivot: func() *pivot {
+				val, _ := New(ClassicName, 3, 0)
+				return val
+			}(),
+			Candles:     []exchange.Candle{candles[1], candles[1]},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful calculation when skipped session is not complete",
+			Pivot: func() *pivot {
+				val, _ := New(ClassicName, 3, 1)
+				return val
+			}(),
+			Candles:     candles,
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 3 candles classic pivot points from the first aligned session",
+			Pivot: func() *pivot {
+				val, _ := New(ClassicName, 3, 0)
+				return val
+			}(),
+			Candles: candles[:5],
+			Result: PivotInfo{
+				Pivot: decimal.New(11, 0),
+				R1:    decimal.New(14, 0),
+				R2:    decimal.New(17, 0),
+				R3:    decimal.New(20, 0),
+				S1:    decimal.New(8, 0),
+				S2:    decimal.New(5, 0),
+				S3:    decimal.New(2, 0),
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles classic pivot points with offset set to 1",
+			Pivot: func() *pivot {
+				val, _ := New(ClassicName, 3, 1)
+				return val
+			}(),
+			Candles: append(candles[1:], exchange.Candle{
+				Timestamp: day.Add(6 * time.Hour),
+				High:      decimal.New(1000, 0),
+				Low:       decimal.New(1, 0),
+				Close:     decimal.New(500, 0),
+			}),
+			Result: PivotInfo{
+				Pivot: decimal.New(11, 0),
+				R1:    decimal.New(14, 0),
+				R2:    decimal.New(17, 0),
+				R3:    decimal.New(20, 0),
+				S1:    decimal.New(8, 0),
+				S2:    decimal.New(5, 0),
+				S3:    decimal.New(2, 0),
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles classic pivot points of the last completed session",
+			Pivot: func() *pivot {
+				val, _ := New(ClassicName, 3, 0)
+				return val
+			}(),
+			Candles: candles,
+			Result: PivotInfo{
+				Pivot: decimal.New(11, 0),
+				R1:    decimal.New(14, 0),
+				R2:    decimal.New(17, 0),
+				R3:    decimal.New(20, 0),
+				S1:    decimal.New(8, 0),
+				S2:    decimal.New(5, 0),
+				S3:    decimal.New(2, 0),
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles Fibonacci pivot points of the last completed session",
+			Pivot: func() *pivot {
+				val, _ := New(FibonacciName, 3, 0)
+				return val
+			}(),
+			Candles: candles,
+			Result: PivotInfo{
+				Pivot: decimal.New(11, 0),
+				R1:    decimal.RequireFromString("13.292"),
+				R2:    decimal.RequireFromString("14.708"),
+				R3:    decimal.New(17, 0),
+				S1:    decimal.RequireFromString("8.708"),
+				S2:    decimal.RequireFromString("7.292"),
+				S3:    decimal.New(5, 0),
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles Camarilla pivot points of the last completed session",
+			Pivot: func() *pivot {
+				val, _ := New(CamarillaName, 3, 0)
+				return val
+			}(),
+			Candles: candles,
+			Result: PivotInfo{
+				Pivot: decimal.New(11, 0),
+				R1:    decimal.RequireFromString("11.55"),
+				R2:    decimal.RequireFromString("12.1"),
+				R3:    decimal.RequireFromString("12.65"),
+				R4:    decimal.RequireFromString("14.3"),
+				S1:    decimal.RequireFromString("10.45"),
+				S2:    decimal.RequireFromString("9.9"),
+				S3:    decimal.RequireFromString("9.35"),
+				S4:    decimal.RequireFromString("7.7"),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Pivot.Calc(v.Candles)
+			assert.True(t, v.Result.Pivot.Equal(res.Pivot.Round(4)))
+			assert.True(t, v.Result.R1.Equal(res.R1.Round(4)))
+			assert.True(t, v.Result.R2.Equal(res.R2.Round(4)))
+			assert.True(t, v.Result.R3.Equal(res.R3.Round(4)))
+			assert.True(t, v.Result.R4.Equal(res.R4.Round(4)))
+			assert.True(t, v.Result.S1.Equal(res.S1.Round(4)))
+			assert.True(t, v.Result.S2.Equal(res.S2.Round(4)))
+			assert.True(t, v.Result.S3.Equal(res.S3.Round(4)))
+			assert.True(t, v.Result.S4.Equal(res.S4.Round(4)))
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package psar
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// PSARConfig contains settings needed
+// to calculate Parabolic SAR.
+type PSARConfig struct {
+	// Period specifies how many candles are
+	// used to calculate PSAR.
+	Period int `json:"period"`
+
+	// Step specifies initial acceleration factor and
+	// its increment value. Use 0.02 when in doubt.
+	Step decimal.Decimal `json:"step"`
+
+	// MaxStep specifies max acceleration factor value.
+	// Use 0.2 when in doubt.
+	MaxStep decimal.Decimal `json:"maxStep"`
+}
+
+// validate checks if PSARConfig values
+// are valid and usable.
+func (p *PSARConfig) Validate() error {
+	if err := ma.PeriodValidation(p.Period); err != nil {
+		return err
+	}
+
+	if p.Period < 2 {
+		return errors.New("period must be at least 2")
+	}
+
+	if p.Step.Sign() <= 0 {
+		return errors.New("step must be a positive value")
+	}
+
+	if p.MaxStep.LessThan(p.Step) || p.MaxStep.GreaterThan(decimal.New(1, 0)) {
+		return errors.New("max step must be between step and 1")
+	}
+
+	return nil
+}
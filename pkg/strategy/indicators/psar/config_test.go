@@ -0,0 +1,63 @@
+package psar
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPSARConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      PSARConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      PSARConfig{Period: 300, Step: decimal.RequireFromString("0.02"), MaxStep: decimal.RequireFromString("0.2")},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when period is too small",
+			Config:      PSARConfig{Period: 1, Step: decimal.RequireFromString("0.02"), MaxStep: decimal.RequireFromString("0.2")},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when step is invalid",
+			Config:      PSARConfig{Period: 50, Step: decimal.Zero, MaxStep: decimal.RequireFromString("0.2")},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when max step is lower than step",
+			Config:      PSARConfig{Period: 50, Step: decimal.RequireFromString("0.02"), MaxStep: decimal.RequireFromString("0.01")},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when max step is too large",
+			Config:      PSARConfig{Period: 50, Step: decimal.RequireFromString("0.02"), MaxStep: decimal.New(2, 0)},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      PSARConfig{Period: 50, Step: decimal.RequireFromString("0.02"), MaxStep: decimal.RequireFromString("0.2")},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Package psar implements Parabolic SAR (Stop And Reverse)
+// indicator calculation logic.
+package psar
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type PSAR interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (PSARInfo, error)
+}
+
+// psar contains internal data values needed
+// to calculate PSARInfo values.
+type psar struct {
+	period  int
+	offset  int
+	step    decimal.Decimal
+	maxStep decimal.Decimal
+}
+
+// PSARInfo contains result values of
+// psar.Calc function.
+type PSARInfo struct {
+	// SAR specifies the latest SAR value.
+	SAR decimal.Decimal `json:"sar"`
+
+	// Uptrend specifies whether SAR is below
+	// the price (uptrend) or above it (downtrend).
+	Uptrend bool `json:"uptrend"`
+}
+
+// New creates new psar object with provided data values.
+func New(period, offset int, step, maxStep decimal.Decimal) (*psar, error) {
+	if period < 2 {
+		return nil, errors.New("PSAR period must be at least 2")
+	}
+
+	if step.Sign() <= 0 || maxStep.LessThan(step) {
+		return nil, errors.New("PSAR step must be positive and not greater than max step")
+	}
+
+	return &psar{
+		period:  period,
+		offset:  offset,
+		step:    step,
+		maxStep: maxStep,
+	}, nil
+}
+
+// NewFromConfig creates new psar object the same way as New,
+// it just takes values from provided PSARConfig.
+func NewFromConfig(conf PSARConfig, offset int) (*psar, error) {
+	return New(conf.Period, offset, conf.Step, conf.MaxStep)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate PSAR with the provided period.
+func (p *psar) CandlesCount() int {
+	return p.period + p.offset
+}
+
+// Calc calculates Parabolic SAR of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns PSARInfo and optionally an error.
+// PSAR calculation:
+//  0. Initial trend is determined by the first two candles' close prices,
+//     initial SAR is the first candle's low (uptrend) or high (downtrend)
+//     and initial extreme point (EP) is its high (uptrend) or low (downtrend);
+//  1. SAR = previous SAR + acceleration factor x (EP - previous SAR);
+//     in uptrend SAR cannot be above two previous candles' lows,
+//     in downtrend SAR cannot be below two previous candles' highs;
+//  2. When new EP is reached, acceleration factor is increased by step
+//     (but cannot exceed max step);
+//  3. When price crosses SAR, trend is reversed: SAR is set to EP,
+//     EP is set to the current candle's high/low and acceleration factor
+//     is reset to step;
+func (p *psar) Calc(cc []exchange.Candle) (PSARInfo, error) {
+	start := p.CandlesCount()
+	end := p.offset
+
+	if cc == nil || len(cc) < start {
+		return PSARInfo{}, errors.New("PSAR candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	up := candles[1].Close.GreaterThanOrEqual(candles[0].Close)
+	af := p.step
+	sar, ep := candles[0].High, candles[0].Low
+	if up {
+		sar, ep = candles[0].Low, candles[0].High
+	}
+
+	for i := 1; i < len(candles); i++ {
+		sar = sar.Add(af.Mul(ep.Sub(sar)))
+
+		if up {
+			sar = decimal.Min(sar, candles[i-1].Low)
+			if i > 1 {
+				sar = decimal.Min(sar, candles[i-2].Low)
+			}
+
+			if candles[i].Low.LessThan(sar) {
+				up = false
+				sar, ep, af = ep, candles[i].Low, p.step
+			} else if candles[i].High.GreaterThan(ep) {
+				ep = candles[i].High
+				af = decimal.Min(af.Add(p.step), p.maxStep)
+			}
+			continue
+		}
+
+		sar = decimal.Max(sar, candles[i-1].High)
+		if i > 1 {
+			sar = decimal.Max(sar, candles[i-2].High)
+		}
+
+		if candles[i].High.GreaterThan(sar) {
+			up = true
+			sar, ep, af = ep, candles[i].High, p.step
+		} else if candles[i].Low.LessThan(ep) {
+			ep = candles[i].Low
+			af = decimal.Min(af.Add(p.step), p.maxStep)
+		}
+	}
+
+	return PSARInfo{
+		SAR:     sar,
+		Uptrend: up,
+	}, nil
+}
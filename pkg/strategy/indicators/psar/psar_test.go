@@ -0,0 +1,222 @@
+package psar
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func candle(high, low, close int64) exchange.Candle {
+	return exchange.Candle{
+		High:  decimal.New(high, 0),
+		Low:   decimal.New(low, 0),
+		Close: decimal.New(close, 0),
+	}
+}
+
+func TestPSARCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		PSAR   *psar
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			PSAR: func() *psar {
+				val, _ := New(30, 2, decimal.RequireFromString("0.02"), decimal.RequireFromString("0.2"))
+				return val
+			}(),
+			Result: 32,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.PSAR.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestPSARNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Step        decimal.Decimal
+		MaxStep     decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful PSAR creation when period is invalid",
+			Period:      1,
+			Step:        decimal.RequireFromString("0.02"),
+			MaxStep:     decimal.RequireFromString("0.2"),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful PSAR creation when step is invalid",
+			Period:      10,
+			Step:        decimal.Zero,
+			MaxStep:     decimal.RequireFromString("0.2"),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful PSAR creation when max step is lower than step",
+			Period:      10,
+			Step:        decimal.RequireFromString("0.02"),
+			MaxStep:     decimal.RequireFromString("0.01"),
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful PSAR creation",
+			Period:      10,
+			Step:        decimal.RequireFromString("0.02"),
+			MaxStep:     decimal.RequireFromString("0.2"),
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, 1, v.Step, v.MaxStep)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestPSARCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		PSAR        *psar
+		Candles     []exchange.Candle
+		Result      PSARInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles PSAR when only 2 provided",
+			PSAR: func() *psar {
+				val, _ := NewFromConfig(PSARConfig{
+					Period:  5,
+					Step:    decimal.RequireFromString("0.02"),
+					MaxStep: decimal.RequireFromString("0.2"),
+				}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 12 candles PSAR in uptrend",
+			PSAR: func() *psar {
+				val, _ := New(12, 0, decimal.RequireFromString("0.02"), decimal.RequireFromString("0.2"))
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+			},
+			Result: PSARInfo{
+				SAR:     decimal.RequireFromString("12.866128"),
+				Uptrend: true,
+			},
+		},
+		{
+			Name: "Successfully calculated 6 candles PSAR with offset set to 6",
+			PSAR: func() *psar {
+				val, _ := New(6, 6, decimal.RequireFromString("0.02"), decimal.RequireFromString("0.2"))
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+			},
+			Result: PSARInfo{
+				SAR:     decimal.RequireFromString("8.956176"),
+				Uptrend: true,
+			},
+		},
+		{
+			Name: "Successfully calculated 9 candles PSAR after trend reversal",
+			PSAR: func() *psar {
+				val, _ := New(9, 0, decimal.RequireFromString("0.02"), decimal.RequireFromString("0.2"))
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(12, 8, 9),
+				candle(11, 7, 8),
+				candle(10, 6, 7),
+			},
+			Result: PSARInfo{
+				SAR:     decimal.RequireFromString("14.5456"),
+				Uptrend: false,
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.PSAR.Calc(v.Candles)
+			if !v.Result.SAR.Equal(res.SAR) {
+				t.Errorf("incorrect SAR result; expected: %s, got: %s", v.Result.SAR.String(), res.SAR.String())
+			}
+			if v.Result.Uptrend != res.Uptrend {
+				t.Errorf("incorrect trend result; expected: %t, got: %t", v.Result.Uptrend, res.Uptrend)
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
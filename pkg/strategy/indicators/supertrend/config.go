@@ -0,0 +1,35 @@
+package supertrend
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// SuperTrendConfig contains settings needed
+// to calculate SuperTrend.
+type SuperTrendConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate ATR.
+	Period int `json:"period"`
+
+	// Multiplier specifies by how much ATR is
+	// multiplied to calculate bands.
+	// Use 3 when in doubt.
+	Multiplier decimal.Decimal `json:"multiplier"`
+}
+
+// validate checks if SuperTrendConfig values
+// are valid and usable.
+func (s *SuperTrendConfig) Validate() error {
+	if err := ma.PeriodValidation(s.Period); err != nil {
+		return err
+	}
+
+	if s.Multiplier.Sign() <= 0 {
+		return errors.New("multiplier must be a positive value")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package supertrend
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSuperTrendConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      SuperTrendConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      SuperTrendConfig{Period: 300, Multiplier: decimal.New(3, 0)},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when multiplier is invalid",
+			Config:      SuperTrendConfig{Period: 10, Multiplier: decimal.Zero},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      SuperTrendConfig{Period: 10, Multiplier: decimal.New(3, 0)},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
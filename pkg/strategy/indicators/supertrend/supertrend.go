@@ -0,0 +1,144 @@
+// Package supertrend implements SuperTrend indicator calculation logic.
+package supertrend
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/atr"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type SuperTrend interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (SuperTrendInfo, error)
+}
+
+// superTrend contains internal data values needed
+// to calculate SuperTrendInfo values.
+type superTrend struct {
+	period     int
+	offset     int
+	multiplier decimal.Decimal
+}
+
+// SuperTrendInfo contains result values of
+// superTrend.Calc function.
+type SuperTrendInfo struct {
+	// Value specifies the latest SuperTrend line value.
+	Value decimal.Decimal `json:"value"`
+
+	// Uptrend specifies whether SuperTrend line is below
+	// the price (uptrend) or above it (downtrend).
+	Uptrend bool `json:"uptrend"`
+}
+
+// New creates new superTrend object with provided data values.
+func New(period, offset int, multiplier decimal.Decimal) (*superTrend, error) {
+	if period <= 0 {
+		return nil, errors.New("SuperTrend period must be positive")
+	}
+
+	if multiplier.Sign() <= 0 {
+		return nil, errors.New("SuperTrend multiplier must be positive")
+	}
+
+	return &superTrend{
+		period:     period,
+		offset:     offset,
+		multiplier: multiplier,
+	}, nil
+}
+
+// NewFromConfig creates new superTrend object the same way as New,
+// it just takes values from provided SuperTrendConfig.
+func NewFromConfig(conf SuperTrendConfig, offset int) (*superTrend, error) {
+	return New(conf.Period, offset, conf.Multiplier)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate SuperTrend with the provided period.
+func (s *superTrend) CandlesCount() int {
+	return s.period*3 + s.offset
+}
+
+// Calc calculates SuperTrend of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns SuperTrendInfo and optionally an error.
+// SuperTrend calculation:
+//  0. ATR is calculated the same way as in atr package;
+//  1. Basic upper band = (high + low) / 2 + multiplier x ATR,
+//     basic lower band = (high + low) / 2 - multiplier x ATR;
+//  2. Final upper band = basic upper band if it is lower than previous
+//     final upper band or previous close is above previous final upper band,
+//     otherwise previous final upper band is used;
+//  3. Final lower band = basic lower band if it is higher than previous
+//     final lower band or previous close is below previous final lower band,
+//     otherwise previous final lower band is used;
+//  4. Trend changes to downtrend when close falls below final lower band
+//     and to uptrend when close rises above final upper band;
+//  5. SuperTrend = final lower band in uptrend, final upper band in downtrend;
+func (s *superTrend) Calc(cc []exchange.Candle) (SuperTrendInfo, error) {
+	start := s.CandlesCount()
+	end := s.offset
+
+	if cc == nil || len(cc) < start {
+		return SuperTrendInfo{}, errors.New("SuperTrend candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+	period := decimal.New(int64(s.period), 0)
+	two := decimal.New(2, 0)
+
+	var (
+		atrVal, upper, lower decimal.Decimal
+		up                   bool
+	)
+
+	for i := 1; i < len(candles); i++ {
+		tr := atr.TrueRange(candles[i], candles[i-1])
+		if i < s.period {
+			atrVal = atrVal.Add(tr)
+			continue
+		}
+
+		if i == s.period {
+			atrVal = atrVal.Add(tr).Div(period)
+		} else {
+			atrVal = atrVal.Mul(decimal.New(int64(s.period-1), 0)).Add(tr).Div(period)
+		}
+
+		mid := candles[i].High.Add(candles[i].Low).Div(two)
+		basicUpper := mid.Add(s.multiplier.Mul(atrVal))
+		basicLower := mid.Sub(s.multiplier.Mul(atrVal))
+
+		if i == s.period {
+			upper, lower = basicUpper, basicLower
+			up = candles[i].Close.GreaterThanOrEqual(mid)
+			continue
+		}
+
+		prevClose := candles[i-1].Close
+		if basicUpper.LessThan(upper) || prevClose.GreaterThan(upper) {
+			upper = basicUpper
+		}
+
+		if basicLower.GreaterThan(lower) || prevClose.LessThan(lower) {
+			lower = basicLower
+		}
+
+		if up && candles[i].Close.LessThan(lower) {
+			up = false
+		} else if !up && candles[i].Close.GreaterThan(upper) {
+			up = true
+		}
+	}
+
+	res := SuperTrendInfo{Value: upper, Uptrend: up}
+	if up {
+		res.Value = lower
+	}
+
+	return res, nil
+}
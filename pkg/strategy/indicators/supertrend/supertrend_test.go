@@ -0,0 +1,205 @@
+package supertrend
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func candle(high, low, close int64) exchange.Candle {
+	return exchange.Candle{
+		High:  decimal.New(high, 0),
+		Low:   decimal.New(low, 0),
+		Close: decimal.New(close, 0),
+	}
+}
+
+func TestSuperTrendCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name       string
+		SuperTrend *superTrend
+		Result     int
+	}{
+		{
+			Name: "Successful count return",
+			SuperTrend: func() *superTrend {
+				val, _ := New(3, 2, decimal.New(3, 0))
+				return val
+			}(),
+			Result: 11,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.SuperTrend.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestSuperTrendNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Multiplier  decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful SuperTrend creation when period is invalid",
+			Period:      0,
+			Multiplier:  decimal.New(3, 0),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful SuperTrend creation when multiplier is invalid",
+			Period:      10,
+			Multiplier:  decimal.New(-1, 0),
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful SuperTrend creation",
+			Period:      10,
+			Multiplier:  decimal.New(3, 0),
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, 1, v.Multiplier)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSuperTrendCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		SuperTrend  *superTrend
+		Candles     []exchange.Candle
+		Result      SuperTrendInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles SuperTrend when only 2 provided",
+			SuperTrend: func() *superTrend {
+				val, _ := NewFromConfig(SuperTrendConfig{Period: 5, Multiplier: decimal.New(3, 0)}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 3 candles SuperTrend in uptrend",
+			SuperTrend: func() *superTrend {
+				val, _ := New(3, 0, decimal.New(1, 0))
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(16, 12, 15),
+				candle(18, 14, 17),
+				candle(17, 15, 16),
+				candle(19, 16, 18),
+				candle(20, 17, 19),
+				candle(18, 15, 16),
+			},
+			Result: SuperTrendInfo{
+				Value:   decimal.RequireFromString("15.2860"),
+				Uptrend: true,
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles SuperTrend in downtrend",
+			SuperTrend: func() *superTrend {
+				val, _ := New(3, 0, decimal.RequireFromString("0.5"))
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(12, 8, 9),
+				candle(11, 7, 8),
+				candle(10, 6, 7),
+			},
+			Result: SuperTrendInfo{
+				Value:   decimal.RequireFromString("9.9012"),
+				Uptrend: false,
+			},
+		},
+		{
+			Name: "Successfully calculated 3 candles SuperTrend with offset set to 1",
+			SuperTrend: func() *superTrend {
+				val, _ := New(3, 1, decimal.RequireFromString("0.5"))
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8, 9),
+				candle(12, 9, 11),
+				candle(11, 10, 10),
+				candle(15, 12, 14),
+				candle(14, 11, 12),
+				candle(13, 9, 10),
+				candle(12, 8, 9),
+				candle(11, 7, 8),
+				candle(10, 6, 7),
+				candle(100, 1, 50),
+			},
+			Result: SuperTrendInfo{
+				Value:   decimal.RequireFromString("9.9012"),
+				Uptrend: false,
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.SuperTrend.Calc(v.Candles)
+			if !v.Result.Value.Equal(res.Value.Round(4)) {
+				t.Errorf("incorrect value result; expected: %s, got: %s", v.Result.Value.String(), res.Value.String())
+			}
+			if v.Result.Uptrend != res.Uptrend {
+				t.Errorf("incorrect trend result; expected: %t, got: %t", v.Result.Uptrend, res.Uptrend)
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
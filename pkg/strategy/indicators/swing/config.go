@@ -0,0 +1,33 @@
+package swing
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+	"errors"
+)
+
+// SwingConfig contains settings needed
+// to calculate swing levels.
+type SwingConfig struct {
+	// Period specifies how many candles should be
+	// searched for swing highs and lows.
+	Period int `json:"period"`
+
+	// Strength specifies how many candles on each side
+	// of the swing point must have lower highs (or higher
+	// lows). Use 2 when in doubt.
+	Strength int `json:"strength"`
+}
+
+// validate checks if SwingConfig values
+// are valid and usable.
+func (s *SwingConfig) Validate() error {
+	if err := ma.PeriodValidation(s.Period); err != nil {
+		return err
+	}
+
+	if s.Strength <= 0 || s.Period <= s.Strength*2 {
+		return errors.New("strength must be positive and less than half of the period")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package swing
+
+import "testing"
+
+func TestSwingConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      SwingConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      SwingConfig{Period: 300, Strength: 2},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when strength is not positive",
+			Config:      SwingConfig{Period: 20},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when strength is too big for the period",
+			Config:      SwingConfig{Period: 4, Strength: 2},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      SwingConfig{Period: 20, Strength: 2},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
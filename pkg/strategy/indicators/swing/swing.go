@@ -0,0 +1,130 @@
+// Package swing implements swing high/low based
+// support and resistance levels calculation logic.
+package swing
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type Swing interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (SwingInfo, error)
+}
+
+// swing contains internal data values
+// needed to calculate SwingInfo values.
+type swing struct {
+	period   int
+	strength int
+	offset   int
+}
+
+// SwingInfo contains result values of
+// swing.Calc function. Zero value means that
+// no swing point was found.
+type SwingInfo struct {
+	// Resistance specifies the latest swing high.
+	Resistance decimal.Decimal `json:"resistance"`
+
+	// Support specifies the latest swing low.
+	Support decimal.Decimal `json:"support"`
+}
+
+// New creates new swing object with provided period,
+// strength and offset to make further calculations.
+func New(period, strength, offset int) (*swing, error) {
+	if strength <= 0 {
+		return nil, errors.New("swing strength must be positive")
+	}
+
+	if period <= strength*2 {
+		return nil, errors.New("swing period must be bigger than double the strength")
+	}
+
+	return &swing{
+		period:   period,
+		strength: strength,
+		offset:   offset,
+	}, nil
+}
+
+// NewFromConfig creates new swing object the same way as New,
+// it just takes values from provided SwingConfig.
+func NewFromConfig(conf SwingConfig, offset int) (*swing, error) {
+	return New(conf.Period, conf.Strength, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate swing levels with the provided period.
+func (s *swing) CandlesCount() int {
+	return s.period + s.offset
+}
+
+// Calc calculates swing levels of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns swing levels calculation result and optionally an error.
+// Swing levels calculation:
+// 1. Swing high = candle which high is higher than highs of
+// strength candles before and after it;
+// 2. Swing low = candle which low is lower than lows of
+// strength candles before and after it;
+// 3. Resistance = the latest swing high, Support = the latest swing low;
+func (s *swing) Calc(cc []exchange.Candle) (SwingInfo, error) {
+	start := s.CandlesCount()
+	end := s.offset
+
+	if cc == nil || len(cc) < start {
+		return SwingInfo{}, errors.New("swing candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	var res SwingInfo
+	for i := len(candles) - s.strength - 1; i >= s.strength; i-- {
+		if res.Resistance.IsZero() && IsHigh(candles, i, s.strength) {
+			res.Resistance = candles[i].High
+		}
+
+		if res.Support.IsZero() && IsLow(candles, i, s.strength) {
+			res.Support = candles[i].Low
+		}
+
+		if !res.Resistance.IsZero() && !res.Support.IsZero() {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// IsHigh checks whether candle at the specified index is a
+// swing high, i.e. its high is higher than highs of strength
+// candles before and after it. Index must have at least
+// strength candles on each side.
+func IsHigh(cc []exchange.Candle, i, strength int) bool {
+	for j := i - strength; j <= i+strength; j++ {
+		if j != i && cc[j].High.GreaterThanOrEqual(cc[i].High) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsLow checks whether candle at the specified index is a
+// swing low, i.e. its low is lower than lows of strength
+// candles before and after it. Index must have at least
+// strength candles on each side.
+func IsLow(cc []exchange.Candle, i, strength int) bool {
+	for j := i - strength; j <= i+strength; j++ {
+		if j != i && cc[j].Low.LessThanOrEqual(cc[i].Low) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package swing
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwingCandlesCount(t *testing.T) {
+	val, _ := New(5, 2, 2)
+	assert.Equal(t, 7, val.CandlesCount())
+}
+
+func TestSwingNew(t *testing.T) {
+	_, err := New(5, 0, 1)
+	assert.NotNil(t, err)
+
+	_, err = New(4, 2, 1)
+	assert.NotNil(t, err)
+
+	_, err = New(5, 2, 1)
+	assert.Nil(t, err)
+}
+
+func TestSwingCalc(t *testing.T) {
+	candle := func(high, low int64) exchange.Candle {
+		return exchange.Candle{High: decimal.New(high, 0), Low: decimal.New(low, 0)}
+	}
+
+	tests := []struct {
+		Name        string
+		Swing       *swing
+		Candles     []exchange.Candle
+		Result      SwingInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles swing levels when only 2 provided",
+			Swing: func() *swing {
+				val, _ := NewFromConfig(SwingConfig{Period: 5, Strength: 1}, 0)
+				return val
+			}(),
+			Candles:     []exchange.Candle{candle(5, 4), candle(6, 5)},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 7 candles swing levels with offset set to 1",
+			Swing: func() *swing {
+				val, _ := New(7, 1, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(100, 1),
+				candle(10, 8),
+				candle(12, 9),
+				candle(11, 7),
+				candle(13, 10),
+				candle(12, 8),
+				candle(14, 11),
+				candle(13, 10),
+				candle(50, 2),
+			},
+			Result: SwingInfo{
+				Resistance: decimal.New(14, 0),
+				Support:    decimal.New(8, 0),
+			},
+		},
+		{
+			Name: "Successfully calculated swing levels when there are no swing points",
+			Swing: func() *swing {
+				val, _ := New(5, 2, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				candle(10, 8),
+				candle(11, 9),
+				candle(12, 10),
+				candle(13, 11),
+				candle(14, 12),
+			},
+			Result: SwingInfo{},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Swing.Calc(v.Candles)
+			assert.True(t, v.Result.Resistance.Equal(res.Resistance))
+			assert.True(t, v.Result.Support.Equal(res.Support))
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
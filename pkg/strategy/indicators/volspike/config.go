@@ -0,0 +1,33 @@
+package volspike
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// VolSpikeConfig contains settings needed
+// to calculate volume spike.
+type VolSpikeConfig struct {
+	// Period specifies how many candles before
+	// the latest one should be used to calculate
+	// average volume.
+	Period int `json:"period"`
+
+	// Volume specifies which candle volume (base, counter)
+	// should be used.
+	Volume string `json:"volume" conform:"trim,lower"`
+}
+
+// validate checks if VolSpikeConfig values
+// are valid and usable.
+func (v *VolSpikeConfig) Validate() error {
+	if err := ma.PeriodValidation(v.Period); err != nil {
+		return err
+	}
+
+	if err := exchange.CandleVolumeValid(v.Volume); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package volspike
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+)
+
+func TestVolSpikeConfigValidation(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      VolSpikeConfig
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when period is invalid",
+			Config:      VolSpikeConfig{Period: 300, Volume: exchange.CounterVolume},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when volume is invalid",
+			Config:      VolSpikeConfig{Period: 10, Volume: "test"},
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful validation",
+			Config:      VolSpikeConfig{Period: 10, Volume: exchange.CounterVolume},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.Config.Validate()
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
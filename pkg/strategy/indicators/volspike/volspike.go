@@ -0,0 +1,88 @@
+// Package volspike implements volume spike indicator calculation logic.
+package volspike
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+type VolSpike interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (VolSpikeInfo, error)
+}
+
+// volSpike contains internal data values needed
+// to calculate VolSpikeInfo values.
+type volSpike struct {
+	period int
+	offset int
+	volume string
+}
+
+// VolSpikeInfo contains result values of
+// volSpike.Calc function.
+type VolSpikeInfo struct {
+	Volume    decimal.Decimal `json:"volume"`
+	AvgVolume decimal.Decimal `json:"avgVolume"`
+}
+
+// New creates new volSpike object with provided period,
+// offset and volume type to make further calculations.
+func New(period, offset int, volume string) (*volSpike, error) {
+	if err := exchange.CandleVolumeValid(volume); err != nil {
+		return nil, err
+	}
+
+	if period <= 0 {
+		return nil, errors.New("volume spike period must be positive")
+	}
+
+	return &volSpike{
+		period: period,
+		offset: offset,
+		volume: volume,
+	}, nil
+}
+
+// NewFromConfig creates new volSpike object the same way as New,
+// it just takes values from provided VolSpikeConfig.
+func NewFromConfig(conf VolSpikeConfig, offset int) (*volSpike, error) {
+	return New(conf.Period, offset, conf.Volume)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate volume spike with the provided period.
+func (v *volSpike) CandlesCount() int {
+	return v.period + 1 + v.offset
+}
+
+// Calc calculates latest candle's volume and average volume of
+// the period candles before it.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns VolSpikeInfo and optionally an error.
+// Calculation:
+// 1. Volume = latest candle's volume;
+// 2. Average volume = Sum(previous candles' volumes) / period;
+func (v *volSpike) Calc(cc []exchange.Candle) (VolSpikeInfo, error) {
+	start := v.CandlesCount()
+	end := v.offset
+
+	if cc == nil || len(cc) < start {
+		return VolSpikeInfo{}, errors.New("volume spike candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	sum := decimal.Zero
+	for _, c := range candles[:len(candles)-1] {
+		sum = sum.Add(c.Volume(v.volume))
+	}
+
+	return VolSpikeInfo{
+		Volume:    candles[len(candles)-1].Volume(v.volume),
+		AvgVolume: sum.Div(decimal.New(int64(v.period), 0)),
+	}, nil
+}
@@ -0,0 +1,150 @@
+package volspike
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolSpikeCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name     string
+		VolSpike *volSpike
+		Result   int
+	}{
+		{
+			Name: "Successful count return",
+			VolSpike: func() *volSpike {
+				val, _ := New(3, 2, exchange.BaseVolume)
+				return val
+			}(),
+			Result: 6,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, v.Result, v.VolSpike.CandlesCount())
+		})
+	}
+}
+
+func TestVolSpikeNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		Volume      string
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful volume spike creation when volume type is invalid",
+			Period:      1,
+			Offset:      1,
+			Volume:      "test",
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful volume spike creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			Volume:      exchange.BaseVolume,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful volume spike creation",
+			Period:      1,
+			Offset:      1,
+			Volume:      exchange.BaseVolume,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset, v.Volume)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVolSpikeCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		VolSpike    *volSpike
+		Candles     []exchange.Candle
+		Result      VolSpikeInfo
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles volume spike when only 2 provided",
+			VolSpike: func() *volSpike {
+				val, _ := NewFromConfig(VolSpikeConfig{Period: 5, Volume: exchange.BaseVolume}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{BaseVolume: decimal.New(4, 0)},
+				{BaseVolume: decimal.New(3, 0)},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 2 candles volume spike",
+			VolSpike: func() *volSpike {
+				val, _ := New(2, 0, exchange.BaseVolume)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{BaseVolume: decimal.New(2, 0)},
+				{BaseVolume: decimal.New(4, 0)},
+				{BaseVolume: decimal.New(9, 0)},
+			},
+			Result: VolSpikeInfo{
+				Volume:    decimal.New(9, 0),
+				AvgVolume: decimal.New(3, 0),
+			},
+		},
+		{
+			Name: "Successfully calculated 2 candles volume spike with counter volume and offset set to 1",
+			VolSpike: func() *volSpike {
+				val, _ := New(2, 1, exchange.CounterVolume)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{CounterVolume: decimal.New(1, 0)},
+				{CounterVolume: decimal.New(3, 0)},
+				{CounterVolume: decimal.New(10, 0)},
+				{CounterVolume: decimal.New(100, 0)},
+			},
+			Result: VolSpikeInfo{
+				Volume:    decimal.New(10, 0),
+				AvgVolume: decimal.New(2, 0),
+			},
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.VolSpike.Calc(v.Candles)
+			assert.True(t, v.Result.Volume.Equal(res.Volume))
+			assert.True(t, v.Result.AvgVolume.Equal(res.AvgVolume))
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
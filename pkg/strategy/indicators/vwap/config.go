@@ -0,0 +1,23 @@
+package vwap
+
+import (
+	"eonbot/pkg/strategy/indicators/ma"
+)
+
+// VWAPConfig contains settings needed
+// to calculate VWAP.
+type VWAPConfig struct {
+	// Period specifies how many candles are
+	// needed to calculate VWAP.
+	Period int `json:"period"`
+}
+
+// validate checks if VWAPConfig values
+// are valid and usable.
+func (v *VWAPConfig) Validate() error {
+	if err := ma.PeriodValidation(v.Period); err != nil {
+		return err
+	}
+
+	return nil
+}
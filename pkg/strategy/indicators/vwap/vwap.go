@@ -0,0 +1,85 @@
+// Package vwap implements VWAP (Volume Weighted Average Price) indicator calculation logic.
+package vwap
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	VWAPName = "vwap"
+)
+
+type VWAP interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+// vwap contains internal data values needed
+// to calculate VWAP.
+type vwap struct {
+	period int
+	offset int
+}
+
+// New creates new VWAP object with provided period
+// and offset to make further calculations.
+func New(period, offset int) (*vwap, error) {
+	if period <= 0 {
+		return nil, errors.New("VWAP period must be positive")
+	}
+
+	return &vwap{
+		period: period,
+		offset: offset,
+	}, nil
+}
+
+// NewFromConfig creates new VWAP object the same way as New,
+// it just takes values from provided VWAPConfig.
+func NewFromConfig(conf VWAPConfig, offset int) (*vwap, error) {
+	return New(conf.Period, offset)
+}
+
+// CandlesCount returns min candle count needed
+// to calculate VWAP with the provided period.
+func (v *vwap) CandlesCount() int {
+	return v.period + v.offset
+}
+
+// Calc calculates VWAP of provided period values.
+// It will slice out only needed candles (period and offset
+// are used to calc boundaries).
+// Returns VWAP calculation result and optionally an error.
+// VWAP calculation:
+// 1. Typical price = (high + low + close) / 3;
+// 2. VWAP = Sum(typical price x base volume) / Sum(base volume);
+// If volume of all candles is zero, average typical price is returned.
+func (v *vwap) Calc(cc []exchange.Candle) (decimal.Decimal, error) {
+	start := v.CandlesCount()
+	end := v.offset
+
+	if cc == nil || len(cc) < start {
+		return decimal.Zero, errors.New("VWAP candles list is too small")
+	}
+
+	candles := cc[len(cc)-start : len(cc)-end]
+
+	totalVal := decimal.Zero
+	totalVol := decimal.Zero
+	totalPrice := decimal.Zero
+	for _, c := range candles {
+		tp := c.TypicalPrice()
+		totalVal = totalVal.Add(tp.Mul(c.BaseVolume))
+		totalVol = totalVol.Add(c.BaseVolume)
+		totalPrice = totalPrice.Add(tp)
+	}
+
+	if totalVol.IsZero() {
+		return totalPrice.Div(decimal.New(int64(len(candles)), 0)), nil
+	}
+
+	return totalVal.Div(totalVol), nil
+}
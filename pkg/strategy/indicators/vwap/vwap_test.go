@@ -0,0 +1,145 @@
+package vwap
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestVWAPCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		VWAP   *vwap
+		Result int
+	}{
+		{
+			Name: "Successful count return",
+			VWAP: func() *vwap {
+				val, _ := New(3, 2)
+				return val
+			}(),
+			Result: 5,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res := v.VWAP.CandlesCount()
+			if res != v.Result {
+				t.Errorf("incorrect result, expected: %d, got: %d", v.Result, res)
+			}
+		})
+	}
+}
+
+func TestVWAPNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Period      int
+		Offset      int
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful VWAP creation when period is invalid",
+			Period:      0,
+			Offset:      1,
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful VWAP creation",
+			Period:      1,
+			Offset:      1,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Period, v.Offset)
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
+
+func TestVWAPCalc(t *testing.T) {
+	tests := []struct {
+		Name        string
+		VWAP        *vwap
+		Candles     []exchange.Candle
+		Result      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful calculation of 5 candles VWAP when only 2 provided",
+			VWAP: func() *vwap {
+				val, _ := NewFromConfig(VWAPConfig{Period: 5}, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{Close: decimal.New(4, 0)},
+				{Close: decimal.New(3, 0)},
+			},
+			Result:      decimal.Zero,
+			ShouldError: true,
+		},
+		{
+			Name: "Successfully calculated 2 candles VWAP",
+			VWAP: func() *vwap {
+				val, _ := New(2, 0)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(50, 0), Low: decimal.New(50, 0), Close: decimal.New(50, 0), BaseVolume: decimal.New(5, 0)},
+				{High: decimal.New(12, 0), Low: decimal.New(8, 0), Close: decimal.New(10, 0), BaseVolume: decimal.New(1, 0)},
+				{High: decimal.New(20, 0), Low: decimal.New(20, 0), Close: decimal.New(20, 0), BaseVolume: decimal.New(3, 0)},
+			},
+			Result: decimal.RequireFromString("17.5"),
+		},
+		{
+			Name: "Successfully calculated 2 candles VWAP with zero volume and offset set to 1",
+			VWAP: func() *vwap {
+				val, _ := New(2, 1)
+				return val
+			}(),
+			Candles: []exchange.Candle{
+				{High: decimal.New(10, 0), Low: decimal.New(10, 0), Close: decimal.New(10, 0)},
+				{High: decimal.New(20, 0), Low: decimal.New(20, 0), Close: decimal.New(20, 0)},
+				{High: decimal.New(50, 0), Low: decimal.New(50, 0), Close: decimal.New(50, 0), BaseVolume: decimal.New(5, 0)},
+			},
+			Result: decimal.New(15, 0),
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.VWAP.Calc(v.Candles)
+			if !v.Result.Equal(res.Round(2)) {
+				t.Errorf("incorrect result; expected: %s, got: %s", v.Result.String(), res.String())
+			}
+			if v.ShouldError {
+				if err == nil {
+					t.Error("error expected, but not returned")
+				}
+			} else {
+				if err != nil {
+					t.Error("error not expected, but returned:", err)
+				}
+			}
+		})
+	}
+}
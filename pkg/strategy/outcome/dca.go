@@ -1,6 +1,7 @@
 package outcome
 
 import (
+	"encoding/json"
 	"errors"
 	"sync"
 )
@@ -39,3 +40,26 @@ func (d *DCA) Increment() {
 	d.StateIndex++
 	d.stateMu.Unlock()
 }
+
+type dcaState struct {
+	StateIndex int `json:"stateIndex"`
+}
+
+func (d *DCA) State() (json.RawMessage, error) {
+	d.stateMu.RLock()
+	st := dcaState{StateIndex: d.StateIndex}
+	d.stateMu.RUnlock()
+	return json.Marshal(st)
+}
+
+func (d *DCA) SetState(data json.RawMessage) error {
+	var st dcaState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return err
+	}
+
+	d.stateMu.Lock()
+	d.StateIndex = st.StateIndex
+	d.stateMu.Unlock()
+	return nil
+}
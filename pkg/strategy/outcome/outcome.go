@@ -22,6 +22,13 @@ type OutcomeType interface {
 	Reset()
 }
 
+// OutcomeStater is an optional interface implemented by
+// outcome types that retain state between cycles.
+type OutcomeStater interface {
+	State() (json.RawMessage, error)
+	SetState(d json.RawMessage) error
+}
+
 type Outcome struct {
 	Type string
 	Raw  []byte
@@ -1,10 +1,18 @@
 package outcome
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"strings"
 	"sync"
+	"text/template"
+	"text/template/parse"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -38,6 +46,12 @@ func (t Telegram) Validate() error {
 		return errors.New("telegram messages list cannot be empty")
 	}
 
+	for i, msg := range t.Messages {
+		if err := validateMsg(msg); err != nil {
+			return fmt.Errorf("telegram message #%d template is invalid: %v", i+1, err)
+		}
+	}
+
 	return nil
 }
 
@@ -47,15 +61,213 @@ func (t *Telegram) Reset() {
 	t.rotMU.Unlock()
 }
 
-func (t *Telegram) Msg() string {
+type telegramState struct {
+	RotIndex int `json:"rotIndex"`
+}
+
+func (t *Telegram) State() (json.RawMessage, error) {
+	t.rotMU.Lock()
+	st := telegramState{RotIndex: t.rotIndex}
+	t.rotMU.Unlock()
+	return json.Marshal(st)
+}
+
+func (t *Telegram) SetState(d json.RawMessage) error {
+	var st telegramState
+	if err := json.Unmarshal(d, &st); err != nil {
+		return err
+	}
+
+	t.rotMU.Lock()
+	if st.RotIndex >= 0 && st.RotIndex < len(t.Messages) {
+		t.rotIndex = st.RotIndex
+	}
+	t.rotMU.Unlock()
+	return nil
+}
+
+// MsgContext contains strategy and market data
+// available in message templates.
+type MsgContext struct {
+	// Pair specifies pair's name, e.g. ETH_BTC.
+	Pair string
+
+	// Strategy specifies name of the strategy
+	// which activated the outcome.
+	Strategy string
+
+	Ticker  MsgTicker
+	Balance MsgBalance
+
+	// BuyPrice specifies average buy price, it
+	// is zero when the stream is in buy mode.
+	BuyPrice decimal.Decimal
+
+	// Profit specifies how much (in percent) the bid
+	// price is above the buy price, it is zero when
+	// the stream is in buy mode.
+	Profit decimal.Decimal
+
+	// Tools specifies strategy's tools snapshot data
+	// by tool ID. Each tool's value is a map of its
+	// snapshot data fields and condsMet field.
+	Tools map[string]interface{}
+}
+
+// MsgTicker contains ticker data
+// available in message templates.
+type MsgTicker struct {
+	Last          decimal.Decimal
+	Ask           decimal.Decimal
+	Bid           decimal.Decimal
+	BaseVolume    decimal.Decimal
+	CounterVolume decimal.Decimal
+	DayChange     decimal.Decimal
+}
+
+// MsgBalance contains pair's balances
+// available in message templates.
+type MsgBalance struct {
+	Base    decimal.Decimal
+	Counter decimal.Decimal
+}
+
+// Msg selects the next message and executes it as a template
+// with the provided context. If execution fails, the raw
+// message is returned together with the error.
+func (t *Telegram) Msg(ctx MsgContext) (string, error) {
+	var msg string
 	switch t.Selection {
 	case RandomSelection:
-		return t.randMsg()
+		msg = t.randMsg()
 	case RotatingSelection:
-		return t.rotMsg()
+		msg = t.rotMsg()
 	default:
-		return ""
+		return "", nil
+	}
+
+	tmpl, err := parseMsg(msg)
+	if err != nil {
+		return msg, err
+	}
+
+	var str strings.Builder
+	if err := tmpl.Execute(&str, ctx); err != nil {
+		return msg, fmt.Errorf("telegram message template execution failed: %v", err)
+	}
+
+	return str.String(), nil
+}
+
+// parseMsg parses message as a text template.
+func parseMsg(msg string) (*template.Template, error) {
+	return template.New("msg").Parse(msg)
+}
+
+// validateMsg parses the message and executes it with zero
+// context, so that invalid fields would be detected before
+// the outcome is activated. Tools' values referenced by the
+// message are set to empty strings.
+func validateMsg(msg string) error {
+	tmpl, err := parseMsg(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx := MsgContext{Tools: make(map[string]interface{})}
+	if tmpl.Tree != nil {
+		collectTools(tmpl.Tree.Root, ctx.Tools)
+	}
+
+	return tmpl.Execute(ioutil.Discard, ctx)
+}
+
+// collectTools finds all .Tools.<id>.<field> references
+// in the template's node tree and adds them to tools map.
+func collectTools(node parse.Node, tools map[string]interface{}) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			collectTools(c, tools)
+		}
+	case *parse.ActionNode:
+		collectTools(n.Pipe, tools)
+	case *parse.IfNode:
+		collectBranchTools(&n.BranchNode, tools)
+	case *parse.RangeNode:
+		collectBranchTools(&n.BranchNode, tools)
+	case *parse.WithNode:
+		collectBranchTools(&n.BranchNode, tools)
+	case *parse.TemplateNode:
+		collectTools(n.Pipe, tools)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectIndexTools(cmd, tools)
+			for _, arg := range cmd.Args {
+				collectTools(arg, tools)
+			}
+		}
+	case *parse.FieldNode:
+		if len(n.Ident) >= 2 && n.Ident[0] == "Tools" {
+			addTool(tools, n.Ident[1:])
+		}
+	}
+}
+
+// collectIndexTools finds index .Tools "<id>" "<field>"
+// references in the command.
+func collectIndexTools(cmd *parse.CommandNode, tools map[string]interface{}) {
+	if len(cmd.Args) < 3 {
+		return
 	}
+
+	if id, ok := cmd.Args[0].(*parse.IdentifierNode); !ok || id.Ident != "index" {
+		return
+	}
+
+	field, ok := cmd.Args[1].(*parse.FieldNode)
+	if !ok || len(field.Ident) != 1 || field.Ident[0] != "Tools" {
+		return
+	}
+
+	path := make([]string, 0, 2)
+	for _, arg := range cmd.Args[2:] {
+		str, ok := arg.(*parse.StringNode)
+		if !ok {
+			break
+		}
+		path = append(path, str.Text)
+	}
+
+	if len(path) > 0 {
+		addTool(tools, path)
+	}
+}
+
+// addTool adds tool's id and optional
+// field (path[1]) to tools map.
+func addTool(tools map[string]interface{}, path []string) {
+	fields, ok := tools[path[0]].(map[string]interface{})
+	if !ok {
+		fields = make(map[string]interface{})
+		tools[path[0]] = fields
+	}
+
+	if len(path) > 1 {
+		fields[path[1]] = ""
+	}
+}
+
+func collectBranchTools(n *parse.BranchNode, tools map[string]interface{}) {
+	collectTools(n.Pipe, tools)
+	collectTools(n.List, tools)
+	collectTools(n.ElseList, tools)
 }
 
 func (t *Telegram) randMsg() (msg string) {
@@ -0,0 +1,100 @@
+package outcome
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTelegramValidate(t *testing.T) {
+	tg := Telegram{Selection: RotatingSelection, Messages: []string{"hello", "{{.Pair}} is up"}}
+	assert.Nil(t, tg.Validate())
+
+	// tools' values are not known in advance.
+	tg.Messages = []string{
+		"{{.Pair}} RSI {{.Tools.rsi1.rsiVal}} - buying at {{.Ticker.Ask}}",
+		`{{index .Tools "rsi-1" "rsiVal"}} {{if .Tools.macd1.condsMet}}{{.Tools.macd1.hist}}{{end}}`,
+	}
+	assert.Nil(t, tg.Validate())
+
+	tg.Messages = []string{"{{.Pair"}
+	assert.NotNil(t, tg.Validate())
+
+	tg.Messages = []string{"{{.Ticker.Aks}}"}
+	assert.NotNil(t, tg.Validate())
+
+	tg.Messages = []string{"{{.Unknown}}"}
+	assert.NotNil(t, tg.Validate())
+}
+
+func TestTelegramMsg(t *testing.T) {
+	ctx := MsgContext{
+		Pair:     "ETH_BTC",
+		Strategy: "entry",
+		Ticker:   MsgTicker{Ask: decimal.New(25, -3), Bid: decimal.New(24, -3)},
+		Balance:  MsgBalance{Counter: decimal.New(15, -1)},
+		Profit:   decimal.New(125, -1),
+		Tools: map[string]interface{}{
+			"rsi1": map[string]interface{}{"rsiVal": "28.5", "condsMet": true},
+		},
+	}
+
+	tests := []struct {
+		Name        string
+		Msg         string
+		Result      string
+		ShouldError bool
+	}{
+		{
+			Name:   "Plain message",
+			Msg:    "hello",
+			Result: "hello",
+		},
+		{
+			Name:   "Market context",
+			Msg:    "{{.Pair}} RSI {{.Tools.rsi1.rsiVal}} - buying at {{.Ticker.Ask}} with {{.Balance.Counter}} ({{.Strategy}})",
+			Result: "ETH_BTC RSI 28.5 - buying at 0.025 with 1.5 (entry)",
+		},
+		{
+			Name:   "Profit",
+			Msg:    "{{if .Profit.IsPositive}}profit: {{.Profit}}%{{end}}",
+			Result: "profit: 12.5%",
+		},
+		{
+			Name:   "Missing tool value",
+			Msg:    "{{.Tools.rsi1.value}}",
+			Result: "<no value>",
+		},
+		{
+			Name:        "Invalid field",
+			Msg:         "{{.Unknown}}",
+			Result:      "{{.Unknown}}",
+			ShouldError: true,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			tg := Telegram{Selection: RotatingSelection, Messages: []string{v.Msg}}
+			res, err := tg.Msg(ctx)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, v.Result, res)
+		})
+	}
+}
+
+func TestTelegramRotation(t *testing.T) {
+	tg := Telegram{Selection: RotatingSelection, Messages: []string{"first {{.Pair}}", "second"}}
+	for _, exp := range []string{"first ETH_BTC", "second", "first ETH_BTC"} {
+		res, err := tg.Msg(MsgContext{Pair: "ETH_BTC"})
+		assert.Nil(t, err)
+		assert.Equal(t, exp, res)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
@@ -81,6 +82,50 @@ func (s *sequence) snapshot() map[string]tools.FullSnapshot {
 	return res
 }
 
+// orderBookDepth returns the max order book depth
+// needed by the sequence's tools.
+func (s *sequence) orderBookDepth() int {
+	var h int
+	for _, tl := range s.toolsList() {
+		user, ok := tl.Properties.(OrderBookUser)
+		if !ok {
+			continue
+		}
+
+		if user.OrderBookDepth() > h {
+			h = user.OrderBookDepth()
+		}
+	}
+
+	return h
+}
+
+// tradesWindow returns the longest recent trades
+// time window needed by the sequence's tools.
+func (s *sequence) tradesWindow() time.Duration {
+	var h time.Duration
+	for _, tl := range s.toolsList() {
+		user, ok := tl.Properties.(TradesUser)
+		if !ok {
+			continue
+		}
+
+		if user.TradesWindow() > h {
+			h = user.TradesWindow()
+		}
+	}
+
+	return h
+}
+
+func (s *sequence) toolsList() []*Tool {
+	res := make([]*Tool, 0)
+	for _, elem := range s.elems {
+		res = append(res, elem.cont.toolsList()...)
+	}
+	return res
+}
+
 func (s *sequence) reset() {
 	for _, elem := range s.elems {
 		elem.cont.reset()
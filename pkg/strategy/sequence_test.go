@@ -4,6 +4,7 @@ import (
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/tools"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -207,6 +208,96 @@ func TestSequenceCandlesCount(t *testing.T) {
 	assert.Equal(t, 38, seq.candlesCount())
 }
 
+func TestSequenceOrderBookDepth(t *testing.T) {
+	seq := sequence{
+		elems: []*seqElem{
+			{
+				cont: &container{
+					tool: &Tool{
+						Properties: &toolPropertiesMock{
+							conf: toolPropertiesMockSettings{
+								BookDepth: 20,
+							},
+						},
+					},
+				},
+			},
+			{
+				cont: &container{
+					seq: &sequence{
+						elems: []*seqElem{
+							{
+								cont: &container{
+									tool: &Tool{
+										Properties: &toolPropertiesMock{
+											conf: toolPropertiesMockSettings{
+												BookDepth: 50,
+											},
+										},
+									},
+								},
+							},
+							{
+								cont: &container{
+									tool: &Tool{
+										Properties: &toolPropertiesMock{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, 50, seq.orderBookDepth())
+}
+
+func TestSequenceTradesWindow(t *testing.T) {
+	seq := sequence{
+		elems: []*seqElem{
+			{
+				cont: &container{
+					tool: &Tool{
+						Properties: &toolPropertiesMock{
+							conf: toolPropertiesMockSettings{
+								TradesWin: 60,
+							},
+						},
+					},
+				},
+			},
+			{
+				cont: &container{
+					seq: &sequence{
+						elems: []*seqElem{
+							{
+								cont: &container{
+									tool: &Tool{
+										Properties: &toolPropertiesMock{
+											conf: toolPropertiesMockSettings{
+												TradesWin: 300,
+											},
+										},
+									},
+								},
+							},
+							{
+								cont: &container{
+									tool: &Tool{
+										Properties: &toolPropertiesMock{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, 5*time.Minute, seq.tradesWindow())
+}
+
 func TestSequenceSnapshot(t *testing.T) {
 	seq := sequence{
 		elems: []*seqElem{
@@ -0,0 +1,142 @@
+package strategy
+
+import (
+	"bytes"
+	"encoding/json"
+	"eonbot/pkg/strategy/outcome"
+)
+
+// State contains strategy's tools and outcomes data
+// that should persist between bot restarts and configs
+// reloads.
+type State struct {
+	// Tools specifies stateful tools data.
+	// The key is tool's ID.
+	Tools map[string]ToolState `json:"tools"`
+
+	// Outcomes specifies outcomes data in the same
+	// order as outcomes are specified in the strategy.
+	Outcomes []OutcomeState `json:"outcomes"`
+}
+
+// ToolState contains specific tool's state data together
+// with its configuration, so that the state would only be
+// restored to the same tool.
+type ToolState struct {
+	Type       string          `json:"type"`
+	Properties json.RawMessage `json:"properties"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// OutcomeState contains specific outcome's state data
+// together with its configuration.
+type OutcomeState struct {
+	Raw  json.RawMessage `json:"raw"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// IsEmpty checks whether state contains any data.
+func (s State) IsEmpty() bool {
+	return len(s.Tools) <= 0 && len(s.Outcomes) <= 0
+}
+
+// State collects current state of all stateful tools
+// and outcomes.
+func (s *Strategy) State() (State, error) {
+	st := State{
+		Tools:    make(map[string]ToolState),
+		Outcomes: make([]OutcomeState, 0),
+	}
+
+	for _, tl := range s.seq.toolsList() {
+		stater, ok := tl.Properties.(ToolStater)
+		if !ok {
+			continue
+		}
+
+		d, err := stater.State()
+		if err != nil {
+			return State{}, s.annErr(err)
+		}
+
+		st.Tools[tl.ID] = ToolState{
+			Type:       tl.Type,
+			Properties: tl.RawProperties,
+			Data:       d,
+		}
+	}
+
+	for _, out := range s.outcomes {
+		outState := OutcomeState{Raw: out.Raw}
+		if stater, ok := out.Conf.(outcome.OutcomeStater); ok {
+			d, err := stater.State()
+			if err != nil {
+				return State{}, s.annErr(err)
+			}
+			outState.Data = d
+		}
+		st.Outcomes = append(st.Outcomes, outState)
+	}
+
+	return st, nil
+}
+
+// SetState restores tools and outcomes state from
+// the provided data. State is restored only to the
+// tools and outcomes whose configuration has not changed
+// since the state was collected, all other state data
+// is ignored.
+func (s *Strategy) SetState(st State) error {
+	for _, tl := range s.seq.toolsList() {
+		tlState, ok := st.Tools[tl.ID]
+		if !ok || tlState.Type != tl.Type || !sameJSON(tlState.Properties, tl.RawProperties) {
+			continue
+		}
+
+		stater, ok := tl.Properties.(ToolStater)
+		if !ok || len(tlState.Data) <= 0 {
+			continue
+		}
+
+		if err := stater.SetState(tlState.Data); err != nil {
+			return s.annErr(err)
+		}
+	}
+
+	for i, out := range s.outcomes {
+		if i >= len(st.Outcomes) {
+			break
+		}
+
+		outState := st.Outcomes[i]
+		if !sameJSON(outState.Raw, out.Raw) {
+			continue
+		}
+
+		stater, ok := out.Conf.(outcome.OutcomeStater)
+		if !ok || len(outState.Data) <= 0 {
+			continue
+		}
+
+		if err := stater.SetState(outState.Data); err != nil {
+			return s.annErr(err)
+		}
+	}
+
+	return nil
+}
+
+// sameJSON checks whether both JSON values are
+// equal, ignoring insignificant whitespace.
+func sameJSON(v1, v2 []byte) bool {
+	var b1, b2 bytes.Buffer
+	if err := json.Compact(&b1, v1); err != nil {
+		return false
+	}
+
+	if err := json.Compact(&b2, v2); err != nil {
+		return false
+	}
+
+	return bytes.Equal(b1.Bytes(), b2.Bytes())
+}
@@ -0,0 +1,82 @@
+package strategy
+
+import (
+	"encoding/json"
+	"eonbot/pkg/strategy/outcome"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newStateTestStrategy(props string, dcaIndex int) *Strategy {
+	return &Strategy{
+		outcomes: []*outcome.Outcome{
+			{
+				Type: outcome.DCAOutcome,
+				Raw:  []byte(`{"type":"dca","properties":{"repeat":3}}`),
+				Conf: &outcome.DCA{
+					Repeat:     3,
+					StateIndex: dcaIndex,
+				},
+			},
+		},
+		seq: &sequence{
+			elems: []*seqElem{
+				{
+					cont: &container{
+						tool: &Tool{
+							ID:            "t1",
+							Type:          testTool,
+							RawProperties: []byte(props),
+							Properties:    &toolPropertiesMock{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestStrategyState(t *testing.T) {
+	strat := newStateTestStrategy(`{"count": 1}`, 2)
+	strat.seq.elems[0].cont.tool.Properties.(*toolPropertiesMock).conf.State = json.RawMessage(`{"val":"1"}`)
+
+	state, err := strat.State()
+	assert.Nil(t, err)
+	assert.False(t, state.IsEmpty())
+	assert.Equal(t, json.RawMessage(`{"val":"1"}`), state.Tools["t1"].Data)
+	assert.Equal(t, json.RawMessage(`{"stateIndex":2}`), state.Outcomes[0].Data)
+
+	// same configuration, different formatting
+	res := newStateTestStrategy(`{
+        "count": 1
+    }`, 0)
+	assert.Nil(t, res.SetState(state))
+	assert.Equal(t, json.RawMessage(`{"val":"1"}`), res.seq.elems[0].cont.tool.Properties.(*toolPropertiesMock).conf.State)
+	assert.Equal(t, 2, res.outcomes[0].Conf.(*outcome.DCA).StateIndex)
+
+	// changed tool configuration
+	res = newStateTestStrategy(`{"count": 2}`, 0)
+	assert.Nil(t, res.SetState(state))
+	assert.Nil(t, res.seq.elems[0].cont.tool.Properties.(*toolPropertiesMock).conf.State)
+	assert.Equal(t, 2, res.outcomes[0].Conf.(*outcome.DCA).StateIndex)
+
+	// changed outcome configuration
+	res = newStateTestStrategy(`{"count": 1}`, 0)
+	res.outcomes[0].Raw = []byte(`{"type":"dca","properties":{"repeat":4}}`)
+	assert.Nil(t, res.SetState(state))
+	assert.Equal(t, 0, res.outcomes[0].Conf.(*outcome.DCA).StateIndex)
+
+	// invalid outcome state data
+	state.Outcomes[0].Data = json.RawMessage(`{`)
+	res = newStateTestStrategy(`{"count": 1}`, 0)
+	assert.NotNil(t, res.SetState(state))
+}
+
+func TestSameJSON(t *testing.T) {
+	assert.True(t, sameJSON([]byte(`{"a": 1}`), []byte(`{
+        "a":1
+    }`)))
+	assert.False(t, sameJSON([]byte(`{"a": 1}`), []byte(`{"a": 2}`)))
+	assert.False(t, sameJSON([]byte(`{`), []byte(`{`)))
+}
@@ -7,6 +7,7 @@ import (
 	"eonbot/pkg/strategy/tools"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -23,6 +24,8 @@ type Strategy struct {
 	seq        *sequence
 	outcomes   []*outcome.Outcome
 	minCandles int
+	bookDepth  int
+	tradesWin  time.Duration
 	stratType  string
 
 	snapshot struct {
@@ -52,6 +55,8 @@ func (s *Strategy) Clone() (*Strategy, error) {
 		seq:        seq,
 		outcomes:   outcomes,
 		minCandles: s.minCandles,
+		bookDepth:  s.bookDepth,
+		tradesWin:  s.tradesWin,
 		stratType:  s.stratType,
 		snapshot: struct {
 			mu       sync.RWMutex
@@ -126,6 +131,20 @@ func (s *Strategy) CandlesNeeded() int {
 	return s.minCandles
 }
 
+// OrderBookDepthNeeded returns how many order book price
+// levels of each side are needed by the strategy's tools
+// (zero if order book is not used at all).
+func (s *Strategy) OrderBookDepthNeeded() int {
+	return s.bookDepth
+}
+
+// TradesWindowNeeded returns how long period of recent
+// trades is needed by the strategy's tools (zero if
+// trades are not used at all).
+func (s *Strategy) TradesWindowNeeded() time.Duration {
+	return s.tradesWin
+}
+
 func (s *Strategy) Type() string {
 	return s.stratType
 }
@@ -202,6 +221,8 @@ func (s *Strategy) UnmarshalJSON(d []byte) (err error) {
 	s.origSeq = nsStrategy.Seq
 	s.seq = seq
 	s.minCandles = s.seq.candlesCount()
+	s.bookDepth = s.seq.orderBookDepth()
+	s.tradesWin = s.seq.tradesWindow()
 
 	if err := s.determineType(); err != nil {
 		return s.annErr(err)
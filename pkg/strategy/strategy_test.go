@@ -80,6 +80,27 @@ func TestStrategyGetters(t *testing.T) {
 	assert.Equal(t, 12, strat.CandlesNeeded())
 }
 
+func TestStrategyCandlesNeededCached(t *testing.T) {
+	// candles count is calculated when strategy is loaded,
+	// tools must not be asked for it on every call.
+	strat := Strategy{
+		minCandles: 12,
+		seq: &sequence{
+			elems: []*seqElem{
+				{
+					cont: &container{
+						tool: &Tool{
+							Type:       "test",
+							Properties: &toolPropertiesMock{conf: toolPropertiesMockSettings{Count: 30}},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, 12, strat.CandlesNeeded())
+}
+
 func TestStrategyReset(t *testing.T) {
 	strat := Strategy{
 		outcomes: []*outcome.Outcome{
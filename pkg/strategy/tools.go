@@ -4,18 +4,39 @@ import (
 	"encoding/json"
 	"errors"
 	"strings"
+	"time"
 
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/tools"
+	toolSchedule "eonbot/pkg/strategy/tools/calendar/schedule"
 	indiBuyPrice "eonbot/pkg/strategy/tools/change/buyprice"
 	toolRollerCoaster "eonbot/pkg/strategy/tools/change/rollercoaster"
 	toolSimpleChange "eonbot/pkg/strategy/tools/change/simple"
+	toolExternal "eonbot/pkg/strategy/tools/custom/external"
+	toolScript "eonbot/pkg/strategy/tools/custom/script"
+	toolOrderBook "eonbot/pkg/strategy/tools/market/orderbook"
+	toolTradeFlow "eonbot/pkg/strategy/tools/market/tradeflow"
+	toolDivergence "eonbot/pkg/strategy/tools/oscillators/divergence"
 	toolMACD "eonbot/pkg/strategy/tools/oscillators/macd"
 	toolRSI "eonbot/pkg/strategy/tools/oscillators/rsi"
 	toolStoch "eonbot/pkg/strategy/tools/oscillators/stoch"
+	toolCandlePattern "eonbot/pkg/strategy/tools/patterns/candlepattern"
+	toolADX "eonbot/pkg/strategy/tools/trends/adx"
+	toolCrossover "eonbot/pkg/strategy/tools/trends/crossover"
+	toolIchimoku "eonbot/pkg/strategy/tools/trends/ichimoku"
+	toolLevels "eonbot/pkg/strategy/tools/trends/levels"
+	toolPSAR "eonbot/pkg/strategy/tools/trends/psar"
+	toolSuperTrend "eonbot/pkg/strategy/tools/trends/supertrend"
 	toolTrail "eonbot/pkg/strategy/tools/trends/trailing"
 	toolBB "eonbot/pkg/strategy/tools/volatility/bb"
+	toolDonchian "eonbot/pkg/strategy/tools/volatility/donchian"
+	toolKeltner "eonbot/pkg/strategy/tools/volatility/keltner"
 	toolMASpread "eonbot/pkg/strategy/tools/volatility/ma_spread"
+	toolCMF "eonbot/pkg/strategy/tools/volume/cmf"
+	toolMFI "eonbot/pkg/strategy/tools/volume/mfi"
+	toolOBV "eonbot/pkg/strategy/tools/volume/obv"
+	toolVolSpike "eonbot/pkg/strategy/tools/volume/volspike"
+	toolVWAP "eonbot/pkg/strategy/tools/volume/vwap"
 
 	"github.com/leebenson/conform"
 )
@@ -31,6 +52,26 @@ const (
 	bb             = "bb"
 	maSpread       = "maspread"
 	trailingTrends = "trailingtrends"
+	script         = "script"
+	external       = "external"
+	obv            = "obv"
+	vwap           = "vwap"
+	mfi            = "mfi"
+	cmf            = "cmf"
+	volumeSpike    = "volumespike"
+	keltner        = "keltner"
+	donchian       = "donchian"
+	adx            = "adx"
+	psar           = "psar"
+	ichimoku       = "ichimoku"
+	superTrend     = "supertrend"
+	crossover      = "crossover"
+	candlePattern  = "candlepattern"
+	levels         = "levels"
+	orderBook      = "orderbook"
+	tradeFlow      = "tradeflow"
+	divergence     = "divergence"
+	schedule       = "schedule"
 )
 
 type Tool struct {
@@ -88,6 +129,28 @@ type ToolProperties interface {
 	Reset()
 }
 
+// OrderBookUser is an optional interface implemented by
+// tools that need order book data. OrderBookDepth returns
+// how many price levels of each side are needed.
+type OrderBookUser interface {
+	OrderBookDepth() int
+}
+
+// TradesUser is an optional interface implemented by
+// tools that need recent public trades. TradesWindow returns
+// how long period of the latest trades is needed.
+type TradesUser interface {
+	TradesWindow() time.Duration
+}
+
+// ToolStater is an optional interface implemented by
+// tools that retain state between cycles. State is used to
+// persist it and SetState to restore it.
+type ToolStater interface {
+	State() (json.RawMessage, error)
+	SetState(d json.RawMessage) error
+}
+
 func newToolProperties(t string, nsProperties json.RawMessage) (ToolProperties, error) {
 	convert := func(target interface{}) error {
 		if err := json.Unmarshal(nsProperties, target); err != nil {
@@ -118,6 +181,46 @@ func newToolProperties(t string, nsProperties json.RawMessage) (ToolProperties,
 		return toolBB.New(convert)
 	case maSpread:
 		return toolMASpread.New(convert)
+	case script:
+		return toolScript.New(convert)
+	case external:
+		return toolExternal.New(convert)
+	case obv:
+		return toolOBV.New(convert)
+	case vwap:
+		return toolVWAP.New(convert)
+	case mfi:
+		return toolMFI.New(convert)
+	case cmf:
+		return toolCMF.New(convert)
+	case volumeSpike:
+		return toolVolSpike.New(convert)
+	case keltner:
+		return toolKeltner.New(convert)
+	case donchian:
+		return toolDonchian.New(convert)
+	case adx:
+		return toolADX.New(convert)
+	case psar:
+		return toolPSAR.New(convert)
+	case ichimoku:
+		return toolIchimoku.New(convert)
+	case superTrend:
+		return toolSuperTrend.New(convert)
+	case crossover:
+		return toolCrossover.New(convert)
+	case candlePattern:
+		return toolCandlePattern.New(convert)
+	case levels:
+		return toolLevels.New(convert)
+	case orderBook:
+		return toolOrderBook.New(convert)
+	case tradeFlow:
+		return toolTradeFlow.New(convert)
+	case divergence:
+		return toolDivergence.New(convert)
+	case schedule:
+		return toolSchedule.New(convert)
 	}
 	return nil, errors.New("tool type not recognized")
 }
@@ -142,12 +245,15 @@ type toolPropertiesMock struct {
 }
 
 type toolPropertiesMockSettings struct {
-	Err      string         `json:"err"`
-	Panic    bool           `json:"panic"`
-	Count    int            `json:"count"`
-	CondsMet bool           `json:"condsMet"`
-	IsReset  bool           `json:"isReset"`
-	Snap     tools.Snapshot `json:"snapshot"`
+	Err       string          `json:"err"`
+	Panic     bool            `json:"panic"`
+	Count     int             `json:"count"`
+	BookDepth int             `json:"bookDepth"`
+	TradesWin int             `json:"tradesWin"`
+	CondsMet  bool            `json:"condsMet"`
+	IsReset   bool            `json:"isReset"`
+	Snap      tools.Snapshot  `json:"snapshot"`
+	State     json.RawMessage `json:"state"`
 }
 
 func newToolSpecsMock(conv func(target interface{}) error) (*toolPropertiesMock, error) {
@@ -185,6 +291,14 @@ func (t *toolPropertiesMock) CandlesCount() int {
 	return t.conf.Count
 }
 
+func (t *toolPropertiesMock) OrderBookDepth() int {
+	return t.conf.BookDepth
+}
+
+func (t *toolPropertiesMock) TradesWindow() time.Duration {
+	return time.Duration(t.conf.TradesWin) * time.Second
+}
+
 func (t *toolPropertiesMock) Snapshot() tools.Snapshot {
 	return t.conf.Snap
 }
@@ -192,3 +306,12 @@ func (t *toolPropertiesMock) Snapshot() tools.Snapshot {
 func (t *toolPropertiesMock) Reset() {
 	t.conf.IsReset = true
 }
+
+func (t *toolPropertiesMock) State() (json.RawMessage, error) {
+	return t.conf.State, nil
+}
+
+func (t *toolPropertiesMock) SetState(d json.RawMessage) error {
+	t.conf.State = d
+	return nil
+}
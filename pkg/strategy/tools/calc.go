@@ -1,13 +1,19 @@
 package tools
 
 import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/atr"
+	"eonbot/pkg/strategy/indicators/ma"
 	"errors"
+
+	"github.com/shopspring/decimal"
 )
 
 const (
 	CalcPercent = "percent"
 	CalcUnits   = "units"
 	CalcFixed   = "fixed"
+	CalcATR     = "atr"
 )
 
 var (
@@ -15,14 +21,76 @@ var (
 )
 
 type Calc struct {
-	Type       string `json:"calcType" conform:"trim,lower"`
+	Type string `json:"calcType" conform:"trim,lower"`
+
+	// ATRPeriod specifies how many candles are needed
+	// to calculate ATR. Used only with ATR calc type.
+	ATRPeriod int `json:"atrPeriod"`
+
 	allowFixed bool
+	allowATR   bool
+
+	atr    atr.ATR
+	atrVal decimal.Decimal
 }
 
 func (c *Calc) AllowFixed() {
 	c.allowFixed = true
 }
 
+func (c *Calc) AllowATR() {
+	c.allowATR = true
+}
+
+// Init prepares data needed by the calc type.
+// Should be called before the first Update call.
+func (c *Calc) Init() error {
+	if c.Type != CalcATR {
+		return nil
+	}
+
+	if !c.allowATR {
+		return ErrCalcInvalid
+	}
+
+	a, err := atr.New(c.ATRPeriod, 0)
+	if err != nil {
+		return err
+	}
+
+	c.atr = a
+	return nil
+}
+
+// Update recalculates calc type's values that depend
+// on the latest candles (e.g. ATR).
+func (c *Calc) Update(cc []exchange.Candle) error {
+	if c.Type != CalcATR {
+		return nil
+	}
+
+	if c.atr == nil {
+		return ErrDataRetrieverNotInitialized
+	}
+
+	val, err := c.atr.Calc(cc)
+	if err != nil {
+		return err
+	}
+
+	c.atrVal = val
+	return nil
+}
+
+// CandlesCount returns candles count needed
+// by the calc type.
+func (c *Calc) CandlesCount() int {
+	if c.atr == nil {
+		return 0
+	}
+	return c.atr.CandlesCount()
+}
+
 func (c *Calc) Validate() error {
 	if err := CalcValidation(c.Type); err != nil {
 		return err
@@ -31,12 +99,22 @@ func (c *Calc) Validate() error {
 	if !c.allowFixed && c.Type == CalcFixed {
 		return ErrCalcInvalid
 	}
+
+	if c.Type == CalcATR {
+		if !c.allowATR {
+			return ErrCalcInvalid
+		}
+
+		if err := ma.PeriodValidation(c.ATRPeriod); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func CalcValidation(c string) error {
 	switch c {
-	case CalcPercent, CalcUnits, CalcFixed:
+	case CalcPercent, CalcUnits, CalcFixed, CalcATR:
 		return nil
 	default:
 		return ErrCalcInvalid
@@ -1,7 +1,13 @@
 package tools
 
 import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/atr_mock"
+	"errors"
 	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCalcValidate(t *testing.T) {
@@ -24,6 +30,25 @@ func TestCalcValidate(t *testing.T) {
 			},
 			ShouldError: true,
 		},
+		{
+			Name: "Unsuccessful validation when calc type is atr, but it is not allowed",
+			C: Calc{
+				Type:      CalcATR,
+				ATRPeriod: 14,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when calc type is atr, but ATR period is invalid",
+			C: func() Calc {
+				val := Calc{
+					Type: CalcATR,
+				}
+				val.AllowATR()
+				return val
+			}(),
+			ShouldError: true,
+		},
 		{
 			Name: "Successful validation",
 			C: func() Calc {
@@ -35,6 +60,18 @@ func TestCalcValidate(t *testing.T) {
 			}(),
 			ShouldError: false,
 		},
+		{
+			Name: "Successful validation when calc type is atr",
+			C: func() Calc {
+				val := Calc{
+					Type:      CalcATR,
+					ATRPeriod: 14,
+				}
+				val.AllowATR()
+				return val
+			}(),
+			ShouldError: false,
+		},
 	}
 
 	for _, v := range tests {
@@ -54,3 +91,125 @@ func TestCalcValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcInit(t *testing.T) {
+	tests := []struct {
+		Name        string
+		C           Calc
+		Candles     int
+		ShouldError bool
+	}{
+		{
+			Name: "Successful init when calc type does not need initialization",
+			C: Calc{
+				Type: CalcUnits,
+			},
+			Candles:     0,
+			ShouldError: false,
+		},
+		{
+			Name: "Unsuccessful init when calc type is atr, but it is not allowed",
+			C: Calc{
+				Type:      CalcATR,
+				ATRPeriod: 14,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful init when calc type is atr, but ATR period is invalid",
+			C: func() Calc {
+				val := Calc{
+					Type: CalcATR,
+				}
+				val.AllowATR()
+				return val
+			}(),
+			ShouldError: true,
+		},
+		{
+			Name: "Successful init when calc type is atr",
+			C: func() Calc {
+				val := Calc{
+					Type:      CalcATR,
+					ATRPeriod: 14,
+				}
+				val.AllowATR()
+				return val
+			}(),
+			Candles:     28,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.C.Init()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Candles, v.C.CandlesCount())
+		})
+	}
+}
+
+func TestCalcUpdate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		C           Calc
+		ATRVal      decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Successful update when calc type does not need updates",
+			C: Calc{
+				Type: CalcPercent,
+			},
+			ATRVal:      decimal.Zero,
+			ShouldError: false,
+		},
+		{
+			Name: "Unsuccessful update when ATR is not initialized",
+			C: Calc{
+				Type: CalcATR,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful update when ATR calc returns an error",
+			C: Calc{
+				Type: CalcATR,
+				atr:  atr_mock.NewATRMock(decimal.Zero, errors.New("test"), 1, 0),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful update when calc type is atr",
+			C: Calc{
+				Type: CalcATR,
+				atr:  atr_mock.NewATRMock(decimal.New(5, 0), nil, 1, 0),
+			},
+			ATRVal:      decimal.New(5, 0),
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			err := v.C.Update([]exchange.Candle{})
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.True(t, v.ATRVal.Equal(v.C.atrVal))
+		})
+	}
+}
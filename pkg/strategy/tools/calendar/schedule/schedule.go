@@ -0,0 +1,66 @@
+package schedule
+
+import (
+	"eonbot/pkg/exchange"
+	sched "eonbot/pkg/schedule"
+	"eonbot/pkg/strategy/tools"
+	"time"
+)
+
+type Schedule struct {
+	conf     settings
+	snapshot tools.SnapshotManager
+
+	// now returns current time, it is replaced
+	// in tests.
+	now func() time.Time
+}
+
+type settings struct {
+	sched.Schedule
+}
+
+type snapshot struct {
+	// Time specifies current time in
+	// the schedule's timezone.
+	Time time.Time `json:"time"`
+}
+
+func New(conf func(v interface{}) error) (*Schedule, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if err := s.Schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Schedule{
+		conf: s,
+		now:  time.Now,
+	}, nil
+}
+
+func (s *Schedule) Validate() error {
+	return s.conf.Schedule.Validate()
+}
+
+func (s *Schedule) ConditionsMet(d exchange.Data) (bool, error) {
+	now := s.now()
+	isMet := s.conf.Schedule.Active(now)
+
+	// collect snapshot data
+	s.snapshot.Set(snapshot{Time: s.conf.Schedule.Local(now)}, isMet)
+	return isMet, nil
+}
+
+func (s *Schedule) CandlesCount() int {
+	return 0
+}
+
+func (s *Schedule) Snapshot() tools.Snapshot {
+	return s.snapshot.Get()
+}
+
+func (s *Schedule) Reset() {}
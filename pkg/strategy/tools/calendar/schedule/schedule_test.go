@@ -0,0 +1,121 @@
+package schedule
+
+import (
+	"eonbot/pkg/exchange"
+	sched "eonbot/pkg/schedule"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduleNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when schedule is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Timezone = "test"
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Timezone = "Europe/London"
+				val.Hours = []sched.Range{{From: "08:00", To: "16:30"}}
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Schedule sched.Schedule
+		Now      time.Time
+		Snapshot tools.Snapshot
+		Result   bool
+	}{
+		{
+			Name: "Successful func call when time is within schedule",
+			Schedule: sched.Schedule{
+				Hours:    []sched.Range{{From: "08:00", To: "16:30"}},
+				Weekdays: []string{"mon"},
+			},
+			Now: time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC),
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{Time: time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC)},
+			},
+			Result: true,
+		},
+		{
+			Name: "Successful func call when time is outside of schedule",
+			Schedule: sched.Schedule{
+				Hours:    []sched.Range{{From: "08:00", To: "16:30"}},
+				Weekdays: []string{"tue"},
+			},
+			Now: time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC),
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{Time: time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC)},
+			},
+			Result: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Nil(t, v.Schedule.Validate())
+			obj := &Schedule{
+				conf: settings{Schedule: v.Schedule},
+				now: func() time.Time {
+					return v.Now
+				},
+			}
+
+			res, err := obj.ConditionsMet(exchange.Data{})
+			assert.Nil(t, err)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, obj.Snapshot())
+		})
+	}
+}
+
+func TestScheduleCandlesCount(t *testing.T) {
+	obj := Schedule{}
+	assert.Equal(t, 0, obj.CandlesCount())
+}
@@ -35,6 +35,11 @@ func New(conf func(v interface{}) error) (*BuyPrice, error) {
 		return nil, err
 	}
 
+	s.Shift.AllowATR()
+	if err := s.Shift.Init(); err != nil {
+		return nil, err
+	}
+
 	return &BuyPrice{
 		conf: s,
 	}, nil
@@ -71,6 +76,11 @@ func (p *BuyPrice) ConditionsMet(d exchange.Data) (bool, error) {
 		return true, nil
 	}
 
+	if err := p.conf.Shift.Update(d.Candles); err != nil {
+		p.snapshot.Clear()
+		return false, err
+	}
+
 	shiftedPrice := p.conf.Shift.CalcVal(d.BuyPrice)
 	isMet := p.conf.Cond.Match(val, shiftedPrice)
 	p.snapshot.Set(snapshot{
@@ -82,6 +92,9 @@ func (p *BuyPrice) ConditionsMet(d exchange.Data) (bool, error) {
 }
 
 func (p *BuyPrice) CandlesCount() int {
+	if p.conf.Shift.CandlesCount() > p.conf.CondObject.CandlesCount() {
+		return p.conf.Shift.CandlesCount()
+	}
 	return p.conf.CondObject.CandlesCount()
 }
 
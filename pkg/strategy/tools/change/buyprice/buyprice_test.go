@@ -160,6 +160,28 @@ func TestBuyPriceValidate(t *testing.T) {
 	}
 }
 
+func atrShift(val string) tools.Shift {
+	s := tools.Shift{
+		ShiftVal: decimal.RequireFromString(val),
+		Calc: tools.Calc{
+			Type:      tools.CalcATR,
+			ATRPeriod: 2,
+		},
+	}
+	s.AllowATR()
+	s.Init()
+	return s
+}
+
+func atrCandles() []exchange.Candle {
+	return []exchange.Candle{
+		{High: decimal.New(10, 0), Low: decimal.New(8, 0), Close: decimal.New(9, 0)},
+		{High: decimal.New(12, 0), Low: decimal.New(9, 0), Close: decimal.New(11, 0)},
+		{High: decimal.New(11, 0), Low: decimal.New(10, 0), Close: decimal.New(10, 0)},
+		{High: decimal.New(15, 0), Low: decimal.New(12, 0), Close: decimal.New(14, 0)},
+	}
+}
+
 func TestBuyPriceConditionsMet(t *testing.T) {
 	tests := []struct {
 		Name        string
@@ -294,6 +316,68 @@ func TestBuyPriceConditionsMet(t *testing.T) {
 			Result:      true,
 			ShouldError: false,
 		},
+		{
+			Name: "Unsuccessful func call when ATR calc returns an error",
+			Tool: BuyPrice{
+				conf: settings{
+					Shift: atrShift("-1"),
+					CondObject: func() tools.CondObject {
+						val := tools.CondObject{
+							Obj: exchange.LastPrice,
+						}
+						val.AllowTickerPrice()
+						val.Init(0)
+						return val
+					}(),
+					Cond: tools.Cond{
+						C: tools.CondBelowOrEqual,
+					},
+				},
+			},
+			Data: exchange.Data{
+				BuyPrice: decimal.RequireFromString("10"),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when shift is calculated with ATR",
+			Tool: BuyPrice{
+				conf: settings{
+					Shift: atrShift("-1"),
+					CondObject: func() tools.CondObject {
+						val := tools.CondObject{
+							Obj: exchange.LastPrice,
+						}
+						val.AllowTickerPrice()
+						val.Init(0)
+						return val
+					}(),
+					Cond: tools.Cond{
+						C: tools.CondBelowOrEqual,
+					},
+				},
+			},
+			Data: exchange.Data{
+				BuyPrice: decimal.RequireFromString("10"),
+				Ticker: exchange.TickerData{
+					LastPrice: decimal.RequireFromString("6"),
+				},
+				Candles: atrCandles(),
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					BuyPrice:        decimal.RequireFromString("10"),
+					ShiftedBuyPrice: decimal.RequireFromString("6.5000000000000000"), // ATR division precision
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.RequireFromString("6"),
+					},
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
 	}
 
 	for _, v := range tests {
@@ -333,6 +417,21 @@ func TestBuyPriceCandlesCount(t *testing.T) {
 			},
 			Result: 0,
 		},
+		{
+			Name: "Successful candles count return when shift is calculated with ATR",
+			Settings: settings{
+				Shift: atrShift("-1"),
+				CondObject: func() tools.CondObject {
+					val := tools.CondObject{
+						Obj: exchange.LastPrice,
+					}
+					val.AllowTickerPrice()
+					val.Init(0)
+					return val
+				}(),
+			},
+			Result: 4,
+		},
 	}
 
 	for _, v := range tests {
@@ -1,6 +1,7 @@
 package rollercoaster
 
 import (
+	"encoding/json"
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/tools"
 	"errors"
@@ -30,6 +31,10 @@ type snapshot struct {
 	tools.CondObjectSnapshot
 }
 
+type state struct {
+	PointVal decimal.Decimal `json:"pointVal"`
+}
+
 func New(conf func(v interface{}) error) (*RollerCoaster, error) {
 	var s settings
 	if err := conf(&s); err != nil {
@@ -40,9 +45,15 @@ func New(conf func(v interface{}) error) (*RollerCoaster, error) {
 	s.CondObject.AllowTickerMiscProp()
 	s.CondObject.AllowCandlePrice()
 	s.CondObject.AllowMA()
+	s.CondObject.AllowVWAP()
 	if err := s.CondObject.Init(0); err != nil {
 		return nil, err
 	}
+
+	s.Shift.AllowATR()
+	if err := s.Shift.Init(); err != nil {
+		return nil, err
+	}
 	return &RollerCoaster{
 		conf: s,
 	}, nil
@@ -82,6 +93,11 @@ func (r *RollerCoaster) ConditionsMet(d exchange.Data) (bool, error) {
 		return false, err
 	}
 
+	if err := r.conf.Shift.Update(d.Candles); err != nil {
+		r.snapshot.Clear()
+		return false, err
+	}
+
 	var isMet bool
 	var shiftedVal decimal.Decimal
 	if r.pointVal.Equal(decimal.Zero) {
@@ -122,6 +138,9 @@ func (r *RollerCoaster) ConditionsMet(d exchange.Data) (bool, error) {
 }
 
 func (r *RollerCoaster) CandlesCount() int {
+	if r.conf.Shift.CandlesCount() > r.conf.CondObject.CandlesCount() {
+		return r.conf.Shift.CandlesCount()
+	}
 	return r.conf.CondObject.CandlesCount()
 }
 
@@ -132,3 +151,17 @@ func (r *RollerCoaster) Snapshot() tools.Snapshot {
 func (r *RollerCoaster) Reset() {
 	r.pointVal = decimal.Zero
 }
+
+func (r *RollerCoaster) State() (json.RawMessage, error) {
+	return json.Marshal(state{PointVal: r.pointVal})
+}
+
+func (r *RollerCoaster) SetState(d json.RawMessage) error {
+	var s state
+	if err := json.Unmarshal(d, &s); err != nil {
+		return err
+	}
+
+	r.pointVal = s.PointVal
+	return nil
+}
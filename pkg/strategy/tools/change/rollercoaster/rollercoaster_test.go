@@ -464,6 +464,31 @@ func TestRollerCoasterCandlesCount(t *testing.T) {
 			},
 			Result: 1,
 		},
+		{
+			Name: "Successful candles count return when shift is calculated with ATR",
+			Settings: settings{
+				CondObject: func() tools.CondObject {
+					val := tools.CondObject{
+						Obj: exchange.ClosePrice,
+					}
+					val.AllowCandlePrice()
+					val.Init(0)
+					return val
+				}(),
+				Shift: func() tools.Shift {
+					val := tools.Shift{
+						Calc: tools.Calc{
+							Type:      tools.CalcATR,
+							ATRPeriod: 14,
+						},
+					}
+					val.AllowATR()
+					val.Init()
+					return val
+				}(),
+			},
+			Result: 28,
+		},
 	}
 
 	for _, v := range tests {
@@ -484,3 +509,14 @@ func TestRollerCoasterReset(t *testing.T) {
 	r.Reset()
 	assert.Equal(t, decimal.Zero, r.pointVal)
 }
+
+func TestRollerCoasterState(t *testing.T) {
+	r := RollerCoaster{pointVal: decimal.New(100, 0)}
+	d, err := r.State()
+	assert.Nil(t, err)
+
+	res := RollerCoaster{}
+	assert.Nil(t, res.SetState(d))
+	assert.True(t, decimal.New(100, 0).Equal(res.pointVal))
+	assert.NotNil(t, res.SetState([]byte("{")))
+}
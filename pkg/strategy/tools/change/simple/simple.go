@@ -1,6 +1,7 @@
 package simple
 
 import (
+	"encoding/json"
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/tools"
 
@@ -26,6 +27,10 @@ type snapshot struct {
 	tools.CondObjectSnapshot
 }
 
+type state struct {
+	ChangeValue decimal.Decimal `json:"changeVal"`
+}
+
 func New(conf func(v interface{}) error) (*SimpleChange, error) {
 	var s settings
 	if err := conf(&s); err != nil {
@@ -36,6 +41,7 @@ func New(conf func(v interface{}) error) (*SimpleChange, error) {
 	s.CondObject.AllowTickerMiscProp()
 	s.CondObject.AllowCandlePrice()
 	s.CondObject.AllowMA()
+	s.CondObject.AllowVWAP()
 	if err := s.CondObject.Init(0); err != nil {
 		return nil, err
 	}
@@ -100,3 +106,17 @@ func (s *SimpleChange) Snapshot() tools.Snapshot {
 func (s *SimpleChange) Reset() {
 	s.val = decimal.Zero
 }
+
+func (s *SimpleChange) State() (json.RawMessage, error) {
+	return json.Marshal(state{ChangeValue: s.val})
+}
+
+func (s *SimpleChange) SetState(d json.RawMessage) error {
+	var st state
+	if err := json.Unmarshal(d, &st); err != nil {
+		return err
+	}
+
+	s.val = st.ChangeValue
+	return nil
+}
@@ -373,3 +373,14 @@ func TestSimpleChangeReset(t *testing.T) {
 	s.Reset()
 	assert.Equal(t, decimal.Zero, s.val)
 }
+
+func TestSimpleChangeState(t *testing.T) {
+	s := SimpleChange{val: decimal.New(100, 0)}
+	d, err := s.State()
+	assert.Nil(t, err)
+
+	res := SimpleChange{}
+	assert.Nil(t, res.SetState(d))
+	assert.True(t, decimal.New(100, 0).Equal(res.val))
+	assert.NotNil(t, res.SetState([]byte("{")))
+}
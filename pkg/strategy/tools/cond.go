@@ -5,6 +5,7 @@ import (
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/indicators"
 	"eonbot/pkg/strategy/indicators/ma"
+	"eonbot/pkg/strategy/indicators/vwap"
 	"errors"
 
 	"github.com/leebenson/conform"
@@ -74,6 +75,7 @@ type CondObject struct {
 	tickerMiscProp bool
 	candlePrice    bool
 	ma             bool
+	vwap           bool
 }
 
 func (c *CondObject) AllowTickerPrice() {
@@ -92,6 +94,10 @@ func (c *CondObject) AllowMA() {
 	c.ma = true
 }
 
+func (c *CondObject) AllowVWAP() {
+	c.vwap = true
+}
+
 func (c *CondObject) Init(offset int) error {
 	if offset < 0 {
 		return errors.New("offset cannot be negative")
@@ -139,7 +145,7 @@ func (c *CondObject) Init(offset int) error {
 			return offset + 1
 		}
 		return nil
-	case ma.SMAName, ma.EMAName, ma.WMAName:
+	case ma.SMAName, ma.EMAName, ma.WMAName, ma.DEMAName, ma.TEMAName, ma.HMAName, ma.KAMAName, ma.VWMAName:
 		if !c.ma {
 			return ErrCondObjectInvalid
 		}
@@ -167,6 +173,34 @@ func (c *CondObject) Init(offset int) error {
 			return ma.CandlesCount()
 		}
 		return nil
+	case vwap.VWAPName:
+		if !c.vwap {
+			return ErrCondObjectInvalid
+		}
+		var conf vwap.VWAPConfig
+		if err := json.Unmarshal(c.ObjConf, &conf); err != nil {
+			return err
+		}
+
+		conform.Strings(&conf)
+
+		if err := conf.Validate(); err != nil {
+			return err
+		}
+
+		v, err := vwap.NewFromConfig(conf, offset)
+		if err != nil {
+			return err
+		}
+
+		c.getData = func(d exchange.Data) (decimal.Decimal, error) {
+			return v.Calc(d.Candles)
+		}
+
+		c.getCandlesCount = func() int {
+			return v.CandlesCount()
+		}
+		return nil
 	default:
 		return ErrCondObjectInvalid
 	}
@@ -189,11 +223,16 @@ func (c *CondObject) Validate() error {
 			return ErrCondObjectInvalid
 		}
 		break
-	case ma.SMAName, ma.EMAName, ma.WMAName:
+	case ma.SMAName, ma.EMAName, ma.WMAName, ma.DEMAName, ma.TEMAName, ma.HMAName, ma.KAMAName, ma.VWMAName:
 		if !c.ma {
 			return ErrCondObjectInvalid
 		}
 		break
+	case vwap.VWAPName:
+		if !c.vwap {
+			return ErrCondObjectInvalid
+		}
+		break
 	default:
 		return ErrCondObjectInvalid
 	}
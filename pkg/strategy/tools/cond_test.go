@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"eonbot/pkg/exchange"
 	"eonbot/pkg/strategy/indicators/ma"
+	"eonbot/pkg/strategy/indicators/vwap"
 	"errors"
 	"testing"
 
@@ -243,6 +244,47 @@ func TestCondObjectInit(t *testing.T) {
 			}(),
 			ShouldError: false,
 		},
+		{
+			Name: "Successful init when object is set to HMA",
+			C: func() CondObject {
+				val := CondObject{
+					Obj:     ma.HMAName,
+					ObjConf: json.RawMessage(`{"price":"close", "period":20}`),
+				}
+				val.AllowMA()
+				return val
+			}(),
+			ShouldError: false,
+		},
+		{
+			Name:        "Unsuccessful init when object is set to VWAP, but VWAP is not allowed",
+			C:           CondObject{Obj: vwap.VWAPName},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful init when object is set to VWAP, but the period is invalid",
+			C: func() CondObject {
+				val := CondObject{
+					Obj:     vwap.VWAPName,
+					ObjConf: json.RawMessage(`{"period":300}`),
+				}
+				val.AllowVWAP()
+				return val
+			}(),
+			ShouldError: true,
+		},
+		{
+			Name: "Successful init when object is set to VWAP",
+			C: func() CondObject {
+				val := CondObject{
+					Obj:     vwap.VWAPName,
+					ObjConf: json.RawMessage(`{"period":20}`),
+				}
+				val.AllowVWAP()
+				return val
+			}(),
+			ShouldError: false,
+		},
 	}
 
 	for _, v := range tests {
@@ -332,6 +374,33 @@ func TestCondObjectValidate(t *testing.T) {
 			}(),
 			ShouldError: false,
 		},
+		{
+			Name: "Successful validation when object is set to VWMA",
+			C: func() CondObject {
+				val := CondObject{
+					Obj: ma.VWMAName,
+				}
+				val.AllowMA()
+				return val
+			}(),
+			ShouldError: false,
+		},
+		{
+			Name:        "Unsuccessful validation when object is set to VWAP, but VWAP is not allowed",
+			C:           CondObject{Obj: vwap.VWAPName},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation when object is set to VWAP",
+			C: func() CondObject {
+				val := CondObject{
+					Obj: vwap.VWAPName,
+				}
+				val.AllowVWAP()
+				return val
+			}(),
+			ShouldError: false,
+		},
 	}
 
 	for _, v := range tests {
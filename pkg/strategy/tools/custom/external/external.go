@@ -0,0 +1,240 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	gopath "path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTimeout = 2000  // milliseconds
+	maxTimeout     = 30000 // milliseconds
+)
+
+var (
+	ErrAddressInvalid = errors.New("external tool address is invalid")
+)
+
+type External struct {
+	addr    url.URL
+	client  *http.Client
+	candles int
+
+	// resetMu prevents multiple reset
+	// requests from running concurrently.
+	resetMu sync.Mutex
+
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	Address string          `json:"address" conform:"trim"`
+	Timeout int             `json:"timeout"`
+	Config  json.RawMessage `json:"config"`
+}
+
+// initRequest is sent to the external tool
+// when the tool is created.
+type initRequest struct {
+	Config json.RawMessage `json:"config"`
+}
+
+// initResponse is expected from the external tool
+// as a response to initRequest.
+type initResponse struct {
+	CandlesCount int `json:"candlesCount"`
+}
+
+// conditionsRequest is sent to the external tool
+// on every conditions check.
+type conditionsRequest struct {
+	Config json.RawMessage `json:"config"`
+	Data   data            `json:"data"`
+}
+
+type data struct {
+	Ticker   exchange.TickerData `json:"ticker"`
+	Candles  []exchange.Candle   `json:"candles"`
+	BuyPrice decimal.Decimal     `json:"buyPrice"`
+}
+
+// conditionsResponse is expected from the external tool
+// as a response to conditionsRequest.
+type conditionsResponse struct {
+	CondsMet bool            `json:"condsMet"`
+	Snapshot json.RawMessage `json:"snapshot"`
+}
+
+func New(conf func(v interface{}) error) (*External, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if s.Timeout == 0 {
+		s.Timeout = defaultTimeout
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(s.Address)
+	if err != nil {
+		return nil, ErrAddressInvalid
+	}
+
+	e := &External{
+		addr: *u,
+		client: &http.Client{
+			Timeout: time.Millisecond * time.Duration(s.Timeout),
+		},
+		conf: s,
+	}
+
+	// retrieve candles count declared by the
+	// external tool.
+	var res initResponse
+	if err := e.post("init", initRequest{Config: s.Config}, &res); err != nil {
+		return nil, err
+	}
+
+	if res.CandlesCount < 0 {
+		return nil, errors.New("external tool candles count cannot be negative")
+	}
+
+	e.candles = res.CandlesCount
+	return e, nil
+}
+
+func (s settings) validate() error {
+	if s.Address == "" {
+		return ErrAddressInvalid
+	}
+
+	u, err := url.Parse(s.Address)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ErrAddressInvalid
+	}
+
+	if s.Timeout < 1 || s.Timeout > maxTimeout {
+		return fmt.Errorf("timeout must be between 1 and %d milliseconds", maxTimeout)
+	}
+
+	return nil
+}
+
+func (e *External) Validate() error {
+	return e.conf.validate()
+}
+
+func (e *External) ConditionsMet(d exchange.Data) (bool, error) {
+	req := conditionsRequest{
+		Config: e.conf.Config,
+		Data: data{
+			Ticker:   d.Ticker,
+			Candles:  d.Candles,
+			BuyPrice: d.BuyPrice,
+		},
+	}
+
+	var res conditionsResponse
+	if err := e.post("conditions", req, &res); err != nil {
+		e.snapshot.Clear()
+		return false, err
+	}
+
+	// collect snapshot data
+	e.snapshot.Set(res.Snapshot, res.CondsMet)
+	return res.CondsMet, nil
+}
+
+func (e *External) CandlesCount() int {
+	return e.candles
+}
+
+func (e *External) Snapshot() tools.Snapshot {
+	return e.snapshot.Get()
+}
+
+// Reset notifies the external tool about reset in a separate
+// goroutine, so that unresponsive tool wouldn't block the stream.
+// Request is bounded by the tool's timeout, errors are only logged,
+// because external tool is responsible for its own state.
+func (e *External) Reset() {
+	go func() {
+		e.resetMu.Lock()
+		defer e.resetMu.Unlock()
+
+		if err := e.post("reset", initRequest{Config: e.conf.Config}, nil); err != nil {
+			logrus.WithField("action", "external tool reset").Error(err)
+		}
+	}()
+}
+
+// post sends JSON request to the specified external
+// tool's endpoint and decodes its response.
+func (e *External) post(path string, body, target interface{}) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return annErr(err)
+	}
+
+	u := e.addr
+	u.Path = gopath.Join("/", e.addr.Path, path)
+
+	resp, err := e.client.Post(u.String(), "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return annErr(err)
+	}
+	defer resp.Body.Close()
+
+	response, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return annErr(err)
+	}
+
+	if resp.StatusCode >= 400 {
+		var respErr struct {
+			Error string `json:"error"`
+		}
+
+		if strings.Contains(string(response), "error") {
+			if err := json.Unmarshal(response, &respErr); err != nil {
+				return annErr(err)
+			}
+		} else {
+			respErr.Error = http.StatusText(resp.StatusCode)
+		}
+
+		return fmt.Errorf("external tool (code: %d): %s", resp.StatusCode, respErr.Error)
+	}
+
+	if target != nil {
+		if err := json.Unmarshal(response, target); err != nil {
+			return annErr(err)
+		}
+	}
+
+	return nil
+}
+
+// annErr annotates all errors returned
+// during communication with the external tool.
+func annErr(err error) error {
+	return fmt.Errorf("external tool: %s", err.Error())
+}
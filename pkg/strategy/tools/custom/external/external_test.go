@@ -0,0 +1,241 @@
+package external
+
+import (
+	"encoding/json"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(initStatus int, initBody string, condsStatus int, condsBody string, delay time.Duration) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/init", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(initStatus)
+		w.Write([]byte(initBody))
+	})
+	mux.HandleFunc("/conditions", func(w http.ResponseWriter, r *http.Request) {
+		var req conditionsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		time.Sleep(delay)
+		w.WriteHeader(condsStatus)
+		w.Write([]byte(condsBody))
+	})
+	mux.HandleFunc("/reset", func(w http.ResponseWriter, r *http.Request) {})
+	return httptest.NewServer(mux)
+}
+
+func conf(addr string, timeout int) func(v interface{}) error {
+	return func(v interface{}) error {
+		return json.Unmarshal([]byte(fmt.Sprintf(`{"address": "%s", "timeout": %d, "config": {"period": 3}}`, addr, timeout)), v)
+	}
+}
+
+func TestExternalNew(t *testing.T) {
+	okSrv := newTestServer(http.StatusOK, `{"candlesCount": 12}`, http.StatusOK, `{}`, 0)
+	defer okSrv.Close()
+
+	errSrv := newTestServer(http.StatusInternalServerError, `{"error": "test"}`, http.StatusOK, `{}`, 0)
+	defer errSrv.Close()
+
+	negSrv := newTestServer(http.StatusOK, `{"candlesCount": -1}`, http.StatusOK, `{}`, 0)
+	defer negSrv.Close()
+
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		Candles     int
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when address is invalid",
+			Conf:        conf("test", 100),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when timeout is invalid",
+			Conf:        conf(okSrv.URL, maxTimeout+1),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when external tool returns error",
+			Conf:        conf(errSrv.URL, 1000),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when external tool returns negative candles count",
+			Conf:        conf(negSrv.URL, 1000),
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful creation",
+			Conf:        conf(okSrv.URL, 1000),
+			Candles:     12,
+			ShouldError: false,
+		},
+	}
+
+	// group parallel tests, so that test servers would
+	// be closed only after all of them complete.
+	t.Run("group", func(t *testing.T) {
+		for _, v := range tests {
+			v := v
+			t.Run(v.Name, func(t *testing.T) {
+				t.Parallel()
+				res, err := New(v.Conf)
+				if v.ShouldError {
+					assert.NotNil(t, err)
+					return
+				}
+
+				assert.Nil(t, err)
+				assert.Nil(t, res.Validate())
+				assert.Equal(t, v.Candles, res.CandlesCount())
+			})
+		}
+	})
+}
+
+func TestExternalConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Status      int
+		Body        string
+		Delay       time.Duration
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when external tool returns error",
+			Status:      http.StatusBadRequest,
+			Body:        `{"error": "test"}`,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful func call when external tool returns invalid response",
+			Status:      http.StatusOK,
+			Body:        `{`,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful func call when external tool times out",
+			Status:      http.StatusOK,
+			Body:        `{"condsMet": true}`,
+			Delay:       time.Millisecond * 300,
+			ShouldError: true,
+		},
+		{
+			Name:   "Successful func call",
+			Status: http.StatusOK,
+			Body:   `{"condsMet": true, "snapshot": {"val": "1"}}`,
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     json.RawMessage(`{"val": "1"}`),
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			srv := newTestServer(http.StatusOK, `{"candlesCount": 1}`, v.Status, v.Body, v.Delay)
+			defer srv.Close()
+
+			tool, err := New(conf(srv.URL, 100))
+			assert.Nil(t, err)
+
+			res, err := tool.ConditionsMet(exchange.Data{
+				Candles:  []exchange.Candle{{Close: decimal.New(1, 0)}},
+				BuyPrice: decimal.New(1, 0),
+			})
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			tool.Reset()
+		})
+	}
+}
+
+func TestExternalBasePath(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/base/init", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"candlesCount": 5}`))
+	})
+	mux.HandleFunc("/base/conditions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"condsMet": true}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tool, err := New(conf(srv.URL+"/base/", 1000))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, tool.CandlesCount())
+
+	res, err := tool.ConditionsMet(exchange.Data{})
+	assert.Nil(t, err)
+	assert.True(t, res)
+}
+
+func TestExternalReset(t *testing.T) {
+	release := make(chan struct{})
+	reset := make(chan struct{}, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/init", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"candlesCount": 1}`))
+	})
+	mux.HandleFunc("/reset", func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		reset <- struct{}{}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	tool, err := New(conf(srv.URL, 1000))
+	assert.Nil(t, err)
+
+	// unresponsive external tool must not block the caller.
+	done := make(chan struct{})
+	go func() {
+		tool.Reset()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("reset blocked the caller")
+	}
+
+	close(release)
+	select {
+	case <-reset:
+	case <-time.After(time.Second):
+		t.Fatal("reset request was not sent")
+	}
+}
@@ -0,0 +1,404 @@
+package script
+
+import (
+	"eonbot/pkg/exchange"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	maxExprLength = 1000 // max amount of symbols in a single expression
+	maxExprNodes  = 200  // max amount of nodes in a single expression
+)
+
+var (
+	ErrTimeout      = errors.New("script execution time limit exceeded")
+	ErrDivByZero    = errors.New("division by zero")
+	ErrExprTooLarge = errors.New("expression is too large")
+)
+
+// kind specifies expression value type.
+type kind int
+
+const (
+	numKind kind = iota + 1
+	boolKind
+)
+
+func (k kind) String() string {
+	switch k {
+	case numKind:
+		return "number"
+	case boolKind:
+		return "bool"
+	default:
+		return "unknown"
+	}
+}
+
+// value holds evaluated expression result.
+type value struct {
+	num decimal.Decimal
+	b   bool
+}
+
+// export returns value in a format that
+// is suitable for snapshot.
+func (v value) export(k kind) interface{} {
+	if k == boolKind {
+		return v.b
+	}
+
+	return v.num
+}
+
+// node is a compiled expression element.
+type node interface {
+	kind() kind
+	eval(e *env) (value, error)
+}
+
+// env holds data that is available to the
+// nodes during evaluation.
+type env struct {
+	data     exchange.Data
+	vars     map[string]value
+	deadline time.Time
+}
+
+// check returns an error if script evaluation time
+// limit was exceeded.
+func (e *env) check() error {
+	if time.Now().After(e.deadline) {
+		return ErrTimeout
+	}
+	return nil
+}
+
+// compiler converts expressions into node trees.
+type compiler struct {
+	// vars specifies variables (and their kinds)
+	// that can be referenced by the expression.
+	vars map[string]kind
+
+	// candles specifies the highest amount
+	// of candles needed by the compiled expressions.
+	candles int
+
+	// nodes specifies current expression's nodes count.
+	nodes int
+}
+
+func newCompiler() *compiler {
+	return &compiler{
+		vars: make(map[string]kind),
+	}
+}
+
+// compile parses and converts expression into node tree.
+func (c *compiler) compile(expr string) (node, error) {
+	if len(expr) > maxExprLength {
+		return nil, ErrExprTooLarge
+	}
+
+	astExpr, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.nodes = 0
+	return c.convert(astExpr)
+}
+
+func (c *compiler) convert(expr ast.Expr) (node, error) {
+	c.nodes++
+	if c.nodes > maxExprNodes {
+		return nil, ErrExprTooLarge
+	}
+
+	switch v := expr.(type) {
+	case *ast.ParenExpr:
+		return c.convert(v.X)
+	case *ast.BasicLit:
+		if v.Kind != token.INT && v.Kind != token.FLOAT {
+			return nil, fmt.Errorf("%s literal cannot be used outside of function arguments", v.Value)
+		}
+
+		num, err := decimal.NewFromString(v.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &numNode{val: num}, nil
+	case *ast.Ident:
+		switch v.Name {
+		case "true":
+			return &boolNode{val: true}, nil
+		case "false":
+			return &boolNode{val: false}, nil
+		}
+
+		k, ok := c.vars[v.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s variable is not defined", v.Name)
+		}
+
+		return &varNode{name: v.Name, k: k}, nil
+	case *ast.UnaryExpr:
+		x, err := c.convert(v.X)
+		if err != nil {
+			return nil, err
+		}
+
+		switch v.Op {
+		case token.SUB, token.ADD:
+			if x.kind() != numKind {
+				return nil, fmt.Errorf("%s operator cannot be used with %s", v.Op, x.kind())
+			}
+		case token.NOT:
+			if x.kind() != boolKind {
+				return nil, fmt.Errorf("%s operator cannot be used with %s", v.Op, x.kind())
+			}
+		default:
+			return nil, fmt.Errorf("%s operator is not supported", v.Op)
+		}
+
+		return &unaryNode{op: v.Op, x: x}, nil
+	case *ast.BinaryExpr:
+		x, err := c.convert(v.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := c.convert(v.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		var k kind
+		switch v.Op {
+		case token.ADD, token.SUB, token.MUL, token.QUO:
+			if x.kind() != numKind || y.kind() != numKind {
+				return nil, fmt.Errorf("%s operator can only be used with numbers", v.Op)
+			}
+			k = numKind
+		case token.LSS, token.GTR, token.LEQ, token.GEQ:
+			if x.kind() != numKind || y.kind() != numKind {
+				return nil, fmt.Errorf("%s operator can only be used with numbers", v.Op)
+			}
+			k = boolKind
+		case token.EQL, token.NEQ:
+			if x.kind() != y.kind() {
+				return nil, fmt.Errorf("%s and %s cannot be compared", x.kind(), y.kind())
+			}
+			k = boolKind
+		case token.LAND, token.LOR:
+			if x.kind() != boolKind || y.kind() != boolKind {
+				return nil, fmt.Errorf("%s operator can only be used with bools", v.Op)
+			}
+			k = boolKind
+		default:
+			return nil, fmt.Errorf("%s operator is not supported", v.Op)
+		}
+
+		return &binaryNode{op: v.Op, x: x, y: y, k: k}, nil
+	case *ast.CallExpr:
+		ident, ok := v.Fun.(*ast.Ident)
+		if !ok {
+			return nil, errors.New("function name is invalid")
+		}
+
+		return c.convertCall(ident.Name, v.Args)
+	default:
+		return nil, errors.New("expression contains unsupported syntax")
+	}
+}
+
+// convertArgs converts function arguments into nodes.
+func (c *compiler) convertArgs(args []ast.Expr) ([]node, error) {
+	res := make([]node, 0, len(args))
+	for _, arg := range args {
+		n, err := c.convert(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.kind() != numKind {
+			return nil, errors.New("function arguments must be numbers")
+		}
+
+		res = append(res, n)
+	}
+
+	return res, nil
+}
+
+// argString returns constant string argument value.
+func argString(arg ast.Expr) (string, error) {
+	lit, ok := arg.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("argument must be a string literal")
+	}
+
+	return strconv.Unquote(lit.Value)
+}
+
+// argInt returns constant int argument value.
+func argInt(arg ast.Expr) (int, error) {
+	lit, ok := arg.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, errors.New("argument must be an integer literal")
+	}
+
+	return strconv.Atoi(lit.Value)
+}
+
+/*
+   Nodes
+*/
+
+type numNode struct {
+	val decimal.Decimal
+}
+
+func (n *numNode) kind() kind {
+	return numKind
+}
+
+func (n *numNode) eval(e *env) (value, error) {
+	return value{num: n.val}, nil
+}
+
+type boolNode struct {
+	val bool
+}
+
+func (n *boolNode) kind() kind {
+	return boolKind
+}
+
+func (n *boolNode) eval(e *env) (value, error) {
+	return value{b: n.val}, nil
+}
+
+type varNode struct {
+	name string
+	k    kind
+}
+
+func (n *varNode) kind() kind {
+	return n.k
+}
+
+func (n *varNode) eval(e *env) (value, error) {
+	return e.vars[n.name], nil
+}
+
+type unaryNode struct {
+	op token.Token
+	x  node
+}
+
+func (n *unaryNode) kind() kind {
+	return n.x.kind()
+}
+
+func (n *unaryNode) eval(e *env) (value, error) {
+	if err := e.check(); err != nil {
+		return value{}, err
+	}
+
+	x, err := n.x.eval(e)
+	if err != nil {
+		return value{}, err
+	}
+
+	switch n.op {
+	case token.SUB:
+		return value{num: x.num.Neg()}, nil
+	case token.NOT:
+		return value{b: !x.b}, nil
+	default:
+		return x, nil
+	}
+}
+
+type binaryNode struct {
+	op token.Token
+	x  node
+	y  node
+	k  kind
+}
+
+func (n *binaryNode) kind() kind {
+	return n.k
+}
+
+func (n *binaryNode) eval(e *env) (value, error) {
+	if err := e.check(); err != nil {
+		return value{}, err
+	}
+
+	x, err := n.x.eval(e)
+	if err != nil {
+		return value{}, err
+	}
+
+	// short-circuit logical operators.
+	switch n.op {
+	case token.LAND:
+		if !x.b {
+			return value{b: false}, nil
+		}
+	case token.LOR:
+		if x.b {
+			return value{b: true}, nil
+		}
+	}
+
+	y, err := n.y.eval(e)
+	if err != nil {
+		return value{}, err
+	}
+
+	switch n.op {
+	case token.ADD:
+		return value{num: x.num.Add(y.num)}, nil
+	case token.SUB:
+		return value{num: x.num.Sub(y.num)}, nil
+	case token.MUL:
+		return value{num: x.num.Mul(y.num)}, nil
+	case token.QUO:
+		if y.num.Equal(decimal.Zero) {
+			return value{}, ErrDivByZero
+		}
+		return value{num: x.num.Div(y.num)}, nil
+	case token.LSS:
+		return value{b: x.num.LessThan(y.num)}, nil
+	case token.GTR:
+		return value{b: x.num.GreaterThan(y.num)}, nil
+	case token.LEQ:
+		return value{b: x.num.LessThanOrEqual(y.num)}, nil
+	case token.GEQ:
+		return value{b: x.num.GreaterThanOrEqual(y.num)}, nil
+	case token.EQL:
+		if n.x.kind() == boolKind {
+			return value{b: x.b == y.b}, nil
+		}
+		return value{b: x.num.Equal(y.num)}, nil
+	case token.NEQ:
+		if n.x.kind() == boolKind {
+			return value{b: x.b != y.b}, nil
+		}
+		return value{b: !x.num.Equal(y.num)}, nil
+	default: // LAND, LOR
+		return value{b: y.b}, nil
+	}
+}
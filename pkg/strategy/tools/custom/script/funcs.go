@@ -0,0 +1,284 @@
+package script
+
+import (
+	"eonbot/pkg/exchange"
+	ebMath "eonbot/pkg/math"
+	"eonbot/pkg/strategy/indicators"
+	"eonbot/pkg/strategy/indicators/ma"
+	indiRSI "eonbot/pkg/strategy/indicators/rsi"
+	"errors"
+	"fmt"
+	"go/ast"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	funcCandle   = "candle"
+	funcTicker   = "ticker"
+	funcBuyPrice = "buyprice"
+	funcRSI      = "rsi"
+	funcAbs      = "abs"
+	funcMin      = "min"
+	funcMax      = "max"
+	funcChange   = "change"
+)
+
+var (
+	ErrBuyPriceMissing = errors.New("buy price does not exist")
+)
+
+// indicator is a common interface of all
+// indicators that can be used in scripts.
+type indicator interface {
+	CandlesCount() int
+	Calc(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+// convertCall converts function call into node.
+func (c *compiler) convertCall(name string, args []ast.Expr) (node, error) {
+	switch name {
+	case ma.SMAName, ma.EMAName, ma.WMAName, ma.DEMAName, ma.TEMAName, ma.HMAName, ma.KAMAName, ma.VWMAName, funcRSI:
+		return c.convertIndicator(name, args)
+	case funcCandle:
+		if len(args) < 1 || len(args) > 2 {
+			return nil, fmt.Errorf("%s function expects 1 or 2 arguments", name)
+		}
+
+		price, err := argString(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if err := exchange.CandlePriceValid(price); err != nil {
+			return nil, err
+		}
+
+		var index int
+		if len(args) == 2 {
+			index, err = argInt(args[1])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if index < 0 {
+			return nil, errors.New("candle index cannot be negative")
+		}
+
+		c.setCandles(index + 1)
+		return &candleNode{price: price, index: index}, nil
+	case funcTicker:
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s function expects 1 argument", name)
+		}
+
+		prop, err := argString(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if exchange.TickerPriceValid(prop) != nil {
+			switch prop {
+			case exchange.OneDayPercent, exchange.TickerBaseVolume, exchange.TickerCounterVolume:
+				break
+			default:
+				return nil, exchange.ErrTickerPropertyInvalid
+			}
+		}
+
+		return &tickerNode{prop: prop}, nil
+	case funcBuyPrice:
+		if len(args) != 0 {
+			return nil, fmt.Errorf("%s function expects no arguments", name)
+		}
+
+		return &buyPriceNode{}, nil
+	case funcAbs, funcMin, funcMax, funcChange:
+		nodes, err := c.convertArgs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if name == funcAbs && len(nodes) != 1 {
+			return nil, fmt.Errorf("%s function expects 1 argument", name)
+		}
+
+		if name != funcAbs && len(nodes) != 2 {
+			return nil, fmt.Errorf("%s function expects 2 arguments", name)
+		}
+
+		return &mathNode{name: name, args: nodes}, nil
+	default:
+		return nil, fmt.Errorf("%s function does not exist", name)
+	}
+}
+
+// convertIndicator converts indicator function call into
+// node. Arguments must be constant: period, price and
+// optional offset.
+func (c *compiler) convertIndicator(name string, args []ast.Expr) (node, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, fmt.Errorf("%s function expects 2 or 3 arguments", name)
+	}
+
+	period, err := argInt(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ma.PeriodValidation(period); err != nil {
+		return nil, err
+	}
+
+	price, err := argString(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := exchange.CandlePriceValid(price); err != nil {
+		return nil, err
+	}
+
+	var offset int
+	if len(args) == 3 {
+		offset, err = argInt(args[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if offset < 0 {
+		return nil, errors.New("offset cannot be negative")
+	}
+
+	var indi indicator
+	if name == funcRSI {
+		indi, err = indiRSI.New(period, offset, price)
+	} else {
+		indi, err = indicators.NewMA(name, price, period, offset)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.setCandles(indi.CandlesCount())
+	return &indicatorNode{indi: indi}, nil
+}
+
+// setCandles updates the highest amount of
+// candles needed.
+func (c *compiler) setCandles(count int) {
+	if count > c.candles {
+		c.candles = count
+	}
+}
+
+/*
+   Function nodes
+*/
+
+type candleNode struct {
+	price string
+	index int
+}
+
+func (n *candleNode) kind() kind {
+	return numKind
+}
+
+func (n *candleNode) eval(e *env) (value, error) {
+	if len(e.data.Candles) <= n.index {
+		return value{}, errors.New("candles list is too small")
+	}
+
+	return value{num: e.data.Candles[len(e.data.Candles)-1-n.index].Price(n.price)}, nil
+}
+
+type tickerNode struct {
+	prop string
+}
+
+func (n *tickerNode) kind() kind {
+	return numKind
+}
+
+func (n *tickerNode) eval(e *env) (value, error) {
+	if exchange.TickerPriceValid(n.prop) == nil {
+		return value{num: e.data.Ticker.Price(n.prop)}, nil
+	}
+
+	return value{num: e.data.Ticker.MiscProp(n.prop)}, nil
+}
+
+type buyPriceNode struct{}
+
+func (n *buyPriceNode) kind() kind {
+	return numKind
+}
+
+func (n *buyPriceNode) eval(e *env) (value, error) {
+	if e.data.BuyPrice.LessThanOrEqual(decimal.Zero) {
+		return value{}, ErrBuyPriceMissing
+	}
+
+	return value{num: e.data.BuyPrice}, nil
+}
+
+type indicatorNode struct {
+	indi indicator
+}
+
+func (n *indicatorNode) kind() kind {
+	return numKind
+}
+
+func (n *indicatorNode) eval(e *env) (value, error) {
+	if err := e.check(); err != nil {
+		return value{}, err
+	}
+
+	res, err := n.indi.Calc(e.data.Candles)
+	if err != nil {
+		return value{}, err
+	}
+
+	return value{num: res}, nil
+}
+
+type mathNode struct {
+	name string
+	args []node
+}
+
+func (n *mathNode) kind() kind {
+	return numKind
+}
+
+func (n *mathNode) eval(e *env) (value, error) {
+	vals := make([]decimal.Decimal, 0, len(n.args))
+	for _, arg := range n.args {
+		v, err := arg.eval(e)
+		if err != nil {
+			return value{}, err
+		}
+		vals = append(vals, v.num)
+	}
+
+	switch n.name {
+	case funcAbs:
+		return value{num: vals[0].Abs()}, nil
+	case funcMin:
+		if vals[1].LessThan(vals[0]) {
+			return value{num: vals[1]}, nil
+		}
+		return value{num: vals[0]}, nil
+	case funcMax:
+		if vals[1].GreaterThan(vals[0]) {
+			return value{num: vals[1]}, nil
+		}
+		return value{num: vals[0]}, nil
+	default: // change
+		return value{num: ebMath.PercentChange(vals[0], vals[1])}, nil
+	}
+}
@@ -0,0 +1,181 @@
+package script
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+const (
+	defaultTimeout = 100  // milliseconds
+	maxTimeout     = 1000 // milliseconds
+	maxVars        = 20
+)
+
+var varNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type Script struct {
+	vars    []compiledVar
+	expr    node
+	candles int
+
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type compiledVar struct {
+	name string
+	n    node
+}
+
+type settings struct {
+	Vars    []Var  `json:"vars"`
+	Expr    string `json:"expr" conform:"trim"`
+	Timeout int    `json:"timeout"`
+}
+
+// Var contains named expression which result
+// can be used by other variables or main expression.
+type Var struct {
+	Name string `json:"name" conform:"trim"`
+	Expr string `json:"expr" conform:"trim"`
+}
+
+type snapshot struct {
+	Vars map[string]interface{} `json:"vars"`
+}
+
+func New(conf func(v interface{}) error) (*Script, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if s.Timeout == 0 {
+		s.Timeout = defaultTimeout
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	c := newCompiler()
+	vars := make([]compiledVar, 0, len(s.Vars))
+	for _, v := range s.Vars {
+		n, err := c.compile(v.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s variable: %s", v.Name, err.Error())
+		}
+
+		c.vars[v.Name] = n.kind()
+		vars = append(vars, compiledVar{name: v.Name, n: n})
+	}
+
+	expr, err := c.compile(s.Expr)
+	if err != nil {
+		return nil, fmt.Errorf("expression: %s", err.Error())
+	}
+
+	if expr.kind() != boolKind {
+		return nil, errors.New("expression must return bool")
+	}
+
+	return &Script{
+		vars:    vars,
+		expr:    expr,
+		candles: c.candles,
+		conf:    s,
+	}, nil
+}
+
+func (s settings) validate() error {
+	if s.Expr == "" {
+		return errors.New("expression cannot be empty")
+	}
+
+	if len(s.Vars) > maxVars {
+		return fmt.Errorf("variables list cannot contain more than %d elements", maxVars)
+	}
+
+	names := make(map[string]bool)
+	for _, v := range s.Vars {
+		if !varNameRegexp.MatchString(v.Name) {
+			return fmt.Errorf("%s variable name is invalid", v.Name)
+		}
+
+		switch v.Name {
+		case "true", "false":
+			return fmt.Errorf("%s variable name is reserved", v.Name)
+		}
+
+		if names[v.Name] {
+			return fmt.Errorf("%s variable is declared more than once", v.Name)
+		}
+		names[v.Name] = true
+
+		if v.Expr == "" {
+			return fmt.Errorf("%s variable expression cannot be empty", v.Name)
+		}
+	}
+
+	if s.Timeout < 1 || s.Timeout > maxTimeout {
+		return fmt.Errorf("timeout must be between 1 and %d milliseconds", maxTimeout)
+	}
+
+	return nil
+}
+
+func (s *Script) Validate() error {
+	if err := s.conf.validate(); err != nil {
+		return err
+	}
+
+	if s.expr == nil {
+		return errors.New("expression is not compiled")
+	}
+
+	return nil
+}
+
+func (s *Script) ConditionsMet(d exchange.Data) (bool, error) {
+	e := &env{
+		data:     d,
+		vars:     make(map[string]value),
+		deadline: time.Now().Add(time.Duration(s.conf.Timeout) * time.Millisecond),
+	}
+
+	snap := make(map[string]interface{})
+	for _, v := range s.vars {
+		val, err := v.n.eval(e)
+		if err != nil {
+			s.snapshot.Clear()
+			return false, fmt.Errorf("%s variable: %s", v.name, err.Error())
+		}
+
+		e.vars[v.name] = val
+		snap[v.name] = val.export(v.n.kind())
+	}
+
+	res, err := s.expr.eval(e)
+	if err != nil {
+		s.snapshot.Clear()
+		return false, err
+	}
+
+	// collect snapshot data
+	s.snapshot.Set(snapshot{Vars: snap}, res.b)
+	return res.b, nil
+}
+
+func (s *Script) CandlesCount() int {
+	return s.candles
+}
+
+func (s *Script) Snapshot() tools.Snapshot {
+	return s.snapshot.Get()
+}
+
+func (s *Script) Reset() {}
@@ -0,0 +1,245 @@
+package script
+
+import (
+	"encoding/json"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func jsonConf(d string) func(v interface{}) error {
+	return func(v interface{}) error {
+		return json.Unmarshal([]byte(d), v)
+	}
+}
+
+func candles(vals ...int64) []exchange.Candle {
+	res := make([]exchange.Candle, 0, len(vals))
+	for _, v := range vals {
+		res = append(res, exchange.Candle{
+			Open:  decimal.New(v, 0),
+			High:  decimal.New(v, 0),
+			Low:   decimal.New(v, 0),
+			Close: decimal.New(v, 0),
+		})
+	}
+	return res
+}
+
+func TestScriptNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		Candles     int
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when expression is empty",
+			Conf:        jsonConf(`{}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when expression does not return bool",
+			Conf:        jsonConf(`{"expr": "1 + 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when expression has syntax error",
+			Conf:        jsonConf(`{"expr": "1 >"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when expression uses unsupported syntax",
+			Conf:        jsonConf(`{"expr": "a[1] > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when expression uses undefined variable",
+			Conf:        jsonConf(`{"expr": "x > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when expression uses unknown function",
+			Conf:        jsonConf(`{"expr": "exec(1) > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when indicator's period is not constant",
+			Conf:        jsonConf(`{"vars": [{"name": "p", "expr": "5"}], "expr": "sma(p, \"close\") > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when indicator's price is invalid",
+			Conf:        jsonConf(`{"expr": "sma(5, \"test\") > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when types mismatch",
+			Conf:        jsonConf(`{"expr": "true > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when variable is declared twice",
+			Conf:        jsonConf(`{"vars": [{"name": "a", "expr": "1"}, {"name": "a", "expr": "2"}], "expr": "a > 2"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when variable name is invalid",
+			Conf:        jsonConf(`{"vars": [{"name": "1a", "expr": "1"}], "expr": "true"}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful creation when timeout is too large",
+			Conf:        jsonConf(`{"expr": "true", "timeout": 5000}`),
+			ShouldError: true,
+		},
+		{
+			Name:        "Successful creation",
+			Conf:        jsonConf(`{"vars": [{"name": "fast", "expr": "ema(3, \"close\")"}, {"name": "slow", "expr": "sma(5, \"close\", 2)"}], "expr": "fast > slow && candle(\"close\", 3) > 0"}`),
+			Candles:     7,
+			ShouldError: false,
+		},
+		{
+			Name:        "Successful creation with additional MA types",
+			Conf:        jsonConf(`{"expr": "hma(4, \"close\") > kama(3, \"close\") && tema(2, \"close\") > 0"}`),
+			Candles:     8,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Candles, res.CandlesCount())
+			assert.Nil(t, res.Validate())
+		})
+	}
+}
+
+func TestScriptConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        string
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when candles list is too small",
+			Conf:        `{"expr": "sma(3, \"close\") > 1"}`,
+			Data:        exchange.Data{Candles: candles(1, 2)},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful func call when buy price does not exist",
+			Conf:        `{"expr": "buyprice() > 1"}`,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful func call when dividing by zero",
+			Conf:        `{"vars": [{"name": "z", "expr": "0"}], "expr": "1 / z > 1"}`,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call",
+			Conf: `{"vars": [
+                {"name": "avg", "expr": "sma(3, \"close\")"},
+                {"name": "up", "expr": "change(candle(\"close\", 1), ticker(\"last\"))"},
+                {"name": "ok", "expr": "up >= 10 || !(avg > 100)"}
+            ], "expr": "ok && max(avg, 1) == avg && abs(-1) == 1"}`,
+			Data: exchange.Data{
+				Ticker:  exchange.TickerData{LastPrice: decimal.New(22, 0)},
+				Candles: candles(10, 20, 30),
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Vars: map[string]interface{}{
+						"avg": decimal.New(20, 0),
+						"up":  decimal.New(10, 0),
+						"ok":  true,
+					},
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call with false result",
+			Conf: `{"expr": "ticker(\"bid\") > buyprice() * 1.1"}`,
+			Data: exchange.Data{
+				Ticker:   exchange.TickerData{BidPrice: decimal.New(105, 0)},
+				BuyPrice: decimal.New(100, 0),
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					Vars: map[string]interface{}{},
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			tool, err := New(jsonConf(v.Conf))
+			assert.Nil(t, err)
+			res, err := tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				assert.Equal(t, tools.Snapshot{}, tool.Snapshot())
+				return
+			}
+
+			assert.Nil(t, err)
+			snap := tool.Snapshot()
+			assert.Equal(t, v.Snapshot.CondsMet, snap.CondsMet)
+			for k, val := range v.Snapshot.Data.(snapshot).Vars {
+				switch exp := val.(type) {
+				case decimal.Decimal:
+					assert.True(t, exp.Equal(snap.Data.(snapshot).Vars[k].(decimal.Decimal)), k)
+				default:
+					assert.Equal(t, exp, snap.Data.(snapshot).Vars[k], k)
+				}
+			}
+		})
+	}
+}
+
+func TestScriptTimeout(t *testing.T) {
+	tool, err := New(jsonConf(`{"expr": "sma(3, \"close\") > 1"}`))
+	assert.Nil(t, err)
+
+	e := &env{
+		data:     exchange.Data{Candles: candles(1, 2, 3)},
+		deadline: time.Now().Add(-time.Second),
+	}
+	_, err = tool.expr.eval(e)
+	assert.Equal(t, ErrTimeout, err)
+}
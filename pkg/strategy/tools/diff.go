@@ -26,12 +26,26 @@ func (d *Diff) PercentDiff(val1, val2 decimal.Decimal) decimal.Decimal {
 	return ebMath.PercentChange(val1, val2)
 }
 
+// ATRDiff calculates units change from val1 to val2
+// expressed in ATR values.
+func (d *Diff) ATRDiff(val1, val2 decimal.Decimal) decimal.Decimal {
+	if d.Calc.atrVal.IsZero() {
+		return decimal.Zero
+	}
+	return ebMath.UnitsChange(val1, val2).Div(d.Calc.atrVal)
+}
+
 func (d *Diff) Diff(val1, val2 decimal.Decimal) decimal.Decimal {
 	switch d.Calc.Type {
 	case CalcUnits:
 		return d.UnitsDiff(val1, val2)
 	case CalcPercent:
 		return d.PercentDiff(val1, val2)
+	case CalcATR:
+		if d.Calc.allowATR {
+			return d.ATRDiff(val1, val2)
+		}
+		return decimal.Zero
 	default:
 		return decimal.Zero
 	}
@@ -79,6 +79,27 @@ func TestDiffDiff(t *testing.T) {
 			Val2:   decimal.RequireFromString("258"),
 			Result: decimal.RequireFromString("126"),
 		},
+		{
+			Name:   "Unsuccessful diff calculation when Calc type is set to atr, but it is not allowed",
+			Diff:   Diff{Calc: Calc{Type: CalcATR, atrVal: decimal.RequireFromString("4")}},
+			Val1:   decimal.RequireFromString("10"),
+			Val2:   decimal.RequireFromString("20"),
+			Result: decimal.Zero,
+		},
+		{
+			Name:   "Successful diff calculation when Calc type is set to atr, but ATR is zero",
+			Diff:   Diff{Calc: Calc{Type: CalcATR, allowATR: true}},
+			Val1:   decimal.RequireFromString("10"),
+			Val2:   decimal.RequireFromString("20"),
+			Result: decimal.Zero,
+		},
+		{
+			Name:   "Successful diff calculation when Calc type is set to atr",
+			Diff:   Diff{Calc: Calc{Type: CalcATR, allowATR: true, atrVal: decimal.RequireFromString("4")}},
+			Val1:   decimal.RequireFromString("20"),
+			Val2:   decimal.RequireFromString("10"),
+			Result: decimal.RequireFromString("-2.5"),
+		},
 	}
 
 	for _, v := range tests {
@@ -0,0 +1,250 @@
+package orderbook
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	metricSpread    = "spread"
+	metricImbalance = "imbalance"
+	metricWall      = "wall"
+
+	sideBids = "bids"
+	sideAsks = "asks"
+
+	defaultDepth = 50
+	maxDepth     = 500
+)
+
+var (
+	defaultWallRatio = decimal.New(5, 0)
+)
+
+type OrderBook struct {
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Metric specifies which order book metric should be
+	// checked: spread, imbalance or wall.
+	Metric string `json:"metric" conform:"trim,lower"`
+
+	// Depth specifies how many price levels of each
+	// side should be retrieved.
+	Depth int `json:"depth"`
+
+	// Range specifies how far (in percent) from the mid price
+	// order book entries are used by imbalance and wall metrics.
+	Range decimal.Decimal `json:"range"`
+
+	// Side specifies which side of the order book
+	// should contain a wall: bids or asks.
+	Side string `json:"side" conform:"trim,lower"`
+
+	// WallRatio specifies how many times entry's amount must
+	// be bigger than average amount of other entries of the
+	// same side to be considered a wall.
+	WallRatio decimal.Decimal `json:"wallRatio"`
+
+	tools.Cond
+	tools.Level
+}
+
+type snapshot struct {
+	MidPrice decimal.Decimal `json:"midPrice"`
+
+	// Spread specifies difference between best ask and bid
+	// rates expressed in percent of the mid price.
+	Spread decimal.Decimal `json:"spread"`
+
+	// Imbalance specifies difference between bids and asks
+	// volumes within the range expressed in percent of
+	// their sum (positive values show buying pressure).
+	Imbalance  *decimal.Decimal `json:"imbalance,omitempty"`
+	BidsVolume *decimal.Decimal `json:"bidsVolume,omitempty"`
+	AsksVolume *decimal.Decimal `json:"asksVolume,omitempty"`
+
+	// Wall specifies the biggest wall found
+	// on the specified side.
+	Wall *exchange.OrderBookEntry `json:"wall,omitempty"`
+}
+
+func New(conf func(v interface{}) error) (*OrderBook, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if s.Depth == 0 {
+		s.Depth = defaultDepth
+	}
+
+	if s.WallRatio.IsZero() {
+		s.WallRatio = defaultWallRatio
+	}
+
+	switch s.Metric {
+	case metricSpread:
+		s.Level.ZeroToHundred()
+	case metricImbalance:
+		s.Level.Init(decimal.New(-100, 0), decimal.New(100, 0))
+	}
+
+	return &OrderBook{
+		conf: s,
+	}, nil
+}
+
+func (o *OrderBook) Validate() error {
+	if o.conf.Depth < 1 || o.conf.Depth > maxDepth {
+		return errors.New("depth must be between 1 and 500 (inclusively)")
+	}
+
+	switch o.conf.Metric {
+	case metricSpread:
+		break
+	case metricImbalance:
+		if err := o.validateRange(); err != nil {
+			return err
+		}
+	case metricWall:
+		if err := o.validateRange(); err != nil {
+			return err
+		}
+
+		switch o.conf.Side {
+		case sideBids, sideAsks:
+			break
+		default:
+			return errors.New("side is invalid")
+		}
+
+		if o.conf.WallRatio.LessThanOrEqual(decimal.New(1, 0)) {
+			return errors.New("wall ratio must be above 1")
+		}
+
+		return nil
+	default:
+		return errors.New("metric is invalid")
+	}
+
+	if err := o.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.conf.Level.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *OrderBook) validateRange() error {
+	if o.conf.Range.LessThanOrEqual(decimal.Zero) || o.conf.Range.GreaterThan(decimal.New(100, 0)) {
+		return errors.New("range must be a value ranging from 0 to 100")
+	}
+
+	return nil
+}
+
+func (o *OrderBook) ConditionsMet(d exchange.Data) (bool, error) {
+	book := d.OrderBook
+	if book.IsEmpty() || !book.MidPrice().IsPositive() {
+		o.snapshot.Clear()
+		return false, errors.New("order book is empty or invalid")
+	}
+
+	snap := snapshot{
+		MidPrice: book.MidPrice(),
+		Spread:   book.Spread().Div(book.MidPrice()).Mul(decimal.New(100, 0)),
+	}
+
+	isMet := false
+	switch o.conf.Metric {
+	case metricSpread:
+		isMet = o.conf.Cond.Match(snap.Spread, o.conf.LevelVal)
+	case metricImbalance:
+		bids := book.BidsVolume(o.conf.Range)
+		asks := book.AsksVolume(o.conf.Range)
+
+		var imbalance decimal.Decimal
+		if total := bids.Add(asks); !total.IsZero() {
+			imbalance = bids.Sub(asks).Div(total).Mul(decimal.New(100, 0))
+		}
+
+		snap.BidsVolume = &bids
+		snap.AsksVolume = &asks
+		snap.Imbalance = &imbalance
+		isMet = o.conf.Cond.Match(imbalance, o.conf.LevelVal)
+	case metricWall:
+		snap.Wall = o.findWall(book)
+		isMet = snap.Wall != nil
+	default:
+		o.snapshot.Clear()
+		return false, errors.New("metric is invalid")
+	}
+
+	// collect snapshot data
+	o.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+// findWall returns the biggest entry within the range which amount
+// exceeds average amount of other side's entries by the wall ratio.
+func (o *OrderBook) findWall(book exchange.OrderBook) *exchange.OrderBookEntry {
+	entries := book.Bids
+	if o.conf.Side == sideAsks {
+		entries = book.Asks
+	}
+
+	if len(entries) < 2 {
+		return nil
+	}
+
+	var total decimal.Decimal
+	for _, e := range entries {
+		total = total.Add(e.Amount)
+	}
+
+	others := decimal.New(int64(len(entries)-1), 0)
+	mid := book.MidPrice()
+
+	var wall *exchange.OrderBookEntry
+	for i := range entries {
+		e := entries[i]
+		diff := e.Rate.Sub(mid).Abs().Div(mid).Mul(decimal.New(100, 0))
+		if diff.GreaterThan(o.conf.Range) {
+			break
+		}
+
+		avg := total.Sub(e.Amount).Div(others)
+		if e.Amount.LessThan(avg.Mul(o.conf.WallRatio)) {
+			continue
+		}
+
+		if wall == nil || e.Amount.GreaterThan(wall.Amount) {
+			wall = &e
+		}
+	}
+
+	return wall
+}
+
+func (o *OrderBook) CandlesCount() int {
+	return 0
+}
+
+func (o *OrderBook) OrderBookDepth() int {
+	return o.conf.Depth
+}
+
+func (o *OrderBook) Snapshot() tools.Snapshot {
+	return o.snapshot.Get()
+}
+
+func (o *OrderBook) Reset() {}
@@ -0,0 +1,372 @@
+package orderbook
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func level(val int64) tools.Level {
+	l := tools.Level{LevelVal: decimal.New(val, 0)}
+	l.Init(decimal.New(-100, 0), decimal.New(100, 0))
+	return l
+}
+
+func entries(vals ...int64) []exchange.OrderBookEntry {
+	res := make([]exchange.OrderBookEntry, 0, len(vals)/2)
+	for i := 0; i+1 < len(vals); i += 2 {
+		res = append(res, exchange.OrderBookEntry{
+			Rate:   decimal.New(vals[i], 0),
+			Amount: decimal.New(vals[i+1], 0),
+		})
+	}
+	return res
+}
+
+func TestOrderBookNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		Depth       int
+		WallRatio   decimal.Decimal
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation with default values",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Metric = metricWall
+				return nil
+			},
+			Depth:       defaultDepth,
+			WallRatio:   defaultWallRatio,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful creation with custom values",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Metric = metricWall
+				val.Depth = 100
+				val.WallRatio = decimal.New(3, 0)
+				return nil
+			},
+			Depth:       100,
+			WallRatio:   decimal.New(3, 0),
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Depth, res.OrderBookDepth())
+			assert.True(t, v.WallRatio.Equal(res.conf.WallRatio))
+		})
+	}
+}
+
+func TestOrderBookValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when depth is invalid",
+			Settings: settings{
+				Metric: metricSpread,
+				Depth:  501,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(1),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when metric is invalid",
+			Settings: settings{
+				Metric: "test",
+				Depth:  50,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(1),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when range is invalid",
+			Settings: settings{
+				Metric: metricImbalance,
+				Depth:  50,
+				Cond:   tools.Cond{C: tools.CondAbove},
+				Level:  level(20),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when side is invalid",
+			Settings: settings{
+				Metric:    metricWall,
+				Depth:     50,
+				Range:     decimal.New(2, 0),
+				Side:      "test",
+				WallRatio: defaultWallRatio,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when wall ratio is invalid",
+			Settings: settings{
+				Metric:    metricWall,
+				Depth:     50,
+				Range:     decimal.New(2, 0),
+				Side:      sideBids,
+				WallRatio: decimal.New(1, 0),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond is invalid",
+			Settings: settings{
+				Metric: metricSpread,
+				Depth:  50,
+				Cond:   tools.Cond{C: "test"},
+				Level:  level(1),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Level is invalid",
+			Settings: settings{
+				Metric: metricImbalance,
+				Depth:  50,
+				Range:  decimal.New(2, 0),
+				Cond:   tools.Cond{C: tools.CondAbove},
+				Level:  level(101),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation with spread metric",
+			Settings: settings{
+				Metric: metricSpread,
+				Depth:  50,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(1),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation with imbalance metric",
+			Settings: settings{
+				Metric: metricImbalance,
+				Depth:  50,
+				Range:  decimal.New(2, 0),
+				Cond:   tools.Cond{C: tools.CondAbove},
+				Level:  level(-20),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation with wall metric",
+			Settings: settings{
+				Metric:    metricWall,
+				Depth:     50,
+				Range:     decimal.New(2, 0),
+				Side:      sideAsks,
+				WallRatio: defaultWallRatio,
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := OrderBook{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestOrderBookConditionsMet(t *testing.T) {
+	data := exchange.Data{
+		OrderBook: exchange.OrderBook{
+			Asks: entries(101, 1, 102, 1, 103, 10),
+			Bids: entries(99, 4, 98, 2, 97, 1),
+		},
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *OrderBook
+		Data        exchange.Data
+		Value       string
+		Wall        *exchange.OrderBookEntry
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when order book is empty",
+			Tool:        &OrderBook{conf: settings{Metric: metricSpread}},
+			Data:        exchange.Data{OrderBook: exchange.OrderBook{Asks: entries(101, 1)}},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful func call when metric is invalid",
+			Tool:        &OrderBook{conf: settings{Metric: "test"}},
+			Data:        data,
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when spread is below the level",
+			Tool: &OrderBook{conf: settings{
+				Metric: metricSpread,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(3),
+			}},
+			Data:        data,
+			Value:       "2",
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when spread is not above the level",
+			Tool: &OrderBook{conf: settings{
+				Metric: metricSpread,
+				Cond:   tools.Cond{C: tools.CondAbove},
+				Level:  level(3),
+			}},
+			Data:        data,
+			Value:       "2",
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when imbalance is above the level",
+			Tool: &OrderBook{conf: settings{
+				Metric: metricImbalance,
+				Range:  decimal.New(2, 0),
+				Cond:   tools.Cond{C: tools.CondAbove},
+				Level:  level(40),
+			}},
+			Data:        data,
+			Value:       "50",
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when imbalance is not below the level",
+			Tool: &OrderBook{conf: settings{
+				Metric: metricImbalance,
+				Range:  decimal.New(5, 0),
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(-30),
+			}},
+			Data:        data,
+			Value:       "-26.31578947368421",
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when wall is found",
+			Tool: &OrderBook{conf: settings{
+				Metric:    metricWall,
+				Range:     decimal.New(5, 0),
+				Side:      sideAsks,
+				WallRatio: defaultWallRatio,
+			}},
+			Data: data,
+			Wall: &exchange.OrderBookEntry{
+				Rate:   decimal.New(103, 0),
+				Amount: decimal.New(10, 0),
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when wall is outside the range",
+			Tool: &OrderBook{conf: settings{
+				Metric:    metricWall,
+				Range:     decimal.New(2, 0),
+				Side:      sideAsks,
+				WallRatio: defaultWallRatio,
+			}},
+			Data:        data,
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when wall is not found",
+			Tool: &OrderBook{conf: settings{
+				Metric:    metricWall,
+				Range:     decimal.New(5, 0),
+				Side:      sideBids,
+				WallRatio: defaultWallRatio,
+			}},
+			Data:        data,
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				assert.Equal(t, tools.Snapshot{}, v.Tool.Snapshot())
+				return
+			}
+
+			assert.Nil(t, err)
+			snap := v.Tool.Snapshot()
+			assert.Equal(t, v.Result, snap.CondsMet)
+
+			data := snap.Data.(snapshot)
+			switch v.Tool.conf.Metric {
+			case metricSpread:
+				assert.Equal(t, v.Value, data.Spread.String())
+			case metricImbalance:
+				assert.Equal(t, v.Value, data.Imbalance.String())
+			case metricWall:
+				assert.Equal(t, v.Wall, data.Wall)
+			}
+		})
+	}
+}
+
+func TestOrderBookCandlesCount(t *testing.T) {
+	obj := OrderBook{conf: settings{Depth: 20}}
+	assert.Equal(t, 0, obj.CandlesCount())
+	assert.Equal(t, 20, obj.OrderBookDepth())
+}
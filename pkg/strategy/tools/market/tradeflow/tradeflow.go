@@ -0,0 +1,256 @@
+package tradeflow
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	metricDelta = "delta"
+	metricBurst = "burst"
+	metricLarge = "large"
+
+	defaultWindow = 300
+	maxWindow     = 86400
+)
+
+type TradeFlow struct {
+	conf     settings
+	snapshot tools.SnapshotManager
+
+	// now returns current time, it is replaced
+	// in tests.
+	now func() time.Time
+}
+
+type settings struct {
+	// Metric specifies which trade flow metric should be
+	// checked: delta, burst or large.
+	Metric string `json:"metric" conform:"trim,lower"`
+
+	// Window specifies how many latest seconds of
+	// trades should be used.
+	Window int `json:"window"`
+
+	// Lookback specifies how many latest seconds of trades
+	// should be used to calculate average trades count which
+	// window's trades count is compared with.
+	Lookback int `json:"lookback"`
+
+	// MinAmount specifies min amount of base asset
+	// a trade must have to be considered large.
+	MinAmount decimal.Decimal `json:"minAmount"`
+
+	// Side specifies which side large trades should
+	// be searched on: buy, sell or both (empty).
+	Side string `json:"side" conform:"trim,lower"`
+
+	tools.Cond
+	tools.Level
+}
+
+type snapshot struct {
+	// Count specifies how many trades were
+	// executed within the window.
+	Count      int             `json:"count"`
+	BuyVolume  decimal.Decimal `json:"buyVolume"`
+	SellVolume decimal.Decimal `json:"sellVolume"`
+
+	// Delta specifies difference between buy and sell
+	// volumes expressed in percent of their sum (positive
+	// values show buying pressure).
+	Delta *decimal.Decimal `json:"delta,omitempty"`
+
+	// BurstRatio specifies how many times window's trades count
+	// exceeds average trades count of the lookback period.
+	BurstRatio *decimal.Decimal `json:"burstRatio,omitempty"`
+
+	// LargeTrade specifies the biggest trade found
+	// within the window.
+	LargeTrade *exchange.Trade `json:"largeTrade,omitempty"`
+}
+
+func New(conf func(v interface{}) error) (*TradeFlow, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if s.Window == 0 {
+		s.Window = defaultWindow
+	}
+
+	if s.Lookback == 0 {
+		s.Lookback = s.Window * 10
+	}
+
+	switch s.Metric {
+	case metricDelta:
+		s.Level.Init(decimal.New(-100, 0), decimal.New(100, 0))
+	case metricBurst:
+		s.Level.ZeroToHundred()
+	}
+
+	return &TradeFlow{
+		conf: s,
+		now:  time.Now,
+	}, nil
+}
+
+func (t *TradeFlow) Validate() error {
+	if t.conf.Window < 1 || t.conf.Window > maxWindow {
+		return errors.New("window must be between 1 and 86400 (inclusively)")
+	}
+
+	switch t.conf.Metric {
+	case metricDelta:
+		break
+	case metricBurst:
+		if t.conf.Lookback < t.conf.Window*2 || t.conf.Lookback > maxWindow {
+			return errors.New("lookback must be at least twice as long as window and not exceed 86400")
+		}
+	case metricLarge:
+		if !t.conf.MinAmount.IsPositive() {
+			return errors.New("min amount must be positive")
+		}
+
+		switch t.conf.Side {
+		case "", exchange.OrderSideBuy, exchange.OrderSideSell:
+			break
+		default:
+			return errors.New("side is invalid")
+		}
+
+		return nil
+	default:
+		return errors.New("metric is invalid")
+	}
+
+	if err := t.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := t.conf.Level.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (t *TradeFlow) ConditionsMet(d exchange.Data) (bool, error) {
+	now := t.now().UTC()
+	start := now.Add(-t.duration(t.conf.Window))
+
+	var snap snapshot
+	for _, tr := range d.Trades {
+		if tr.Timestamp.Before(start) {
+			continue
+		}
+
+		snap.Count++
+		switch tr.Side {
+		case exchange.OrderSideBuy:
+			snap.BuyVolume = snap.BuyVolume.Add(tr.Amount)
+		case exchange.OrderSideSell:
+			snap.SellVolume = snap.SellVolume.Add(tr.Amount)
+		}
+	}
+
+	isMet := false
+	switch t.conf.Metric {
+	case metricDelta:
+		var delta decimal.Decimal
+		if total := snap.BuyVolume.Add(snap.SellVolume); !total.IsZero() {
+			delta = snap.BuyVolume.Sub(snap.SellVolume).Div(total).Mul(decimal.New(100, 0))
+		}
+
+		snap.Delta = &delta
+		isMet = t.conf.Cond.Match(delta, t.conf.LevelVal)
+	case metricBurst:
+		ratio := t.burstRatio(d.Trades, now, snap.Count)
+		snap.BurstRatio = &ratio
+		isMet = t.conf.Cond.Match(ratio, t.conf.LevelVal)
+	case metricLarge:
+		snap.LargeTrade = t.findLarge(d.Trades, start)
+		isMet = snap.LargeTrade != nil
+	default:
+		t.snapshot.Clear()
+		return false, errors.New("metric is invalid")
+	}
+
+	// collect snapshot data
+	t.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+// burstRatio compares window's trades count with average
+// trades count of the same length period before the window.
+// At least one trade is assumed to be executed before
+// the window to avoid division by zero.
+func (t *TradeFlow) burstRatio(trades []exchange.Trade, now time.Time, count int) decimal.Decimal {
+	start := now.Add(-t.duration(t.conf.Lookback))
+	end := now.Add(-t.duration(t.conf.Window))
+
+	var baseline int64
+	for _, tr := range trades {
+		if tr.Timestamp.Before(start) || !tr.Timestamp.Before(end) {
+			continue
+		}
+		baseline++
+	}
+
+	if baseline == 0 {
+		baseline = 1
+	}
+
+	avg := decimal.New(baseline*int64(t.conf.Window), 0).Div(decimal.New(int64(t.conf.Lookback-t.conf.Window), 0))
+	return decimal.New(int64(count), 0).Div(avg)
+}
+
+// findLarge returns the biggest trade within the window
+// which amount is not lower than the min amount.
+func (t *TradeFlow) findLarge(trades []exchange.Trade, start time.Time) *exchange.Trade {
+	var large *exchange.Trade
+	for i := range trades {
+		tr := trades[i]
+		if tr.Timestamp.Before(start) || tr.Amount.LessThan(t.conf.MinAmount) {
+			continue
+		}
+
+		if t.conf.Side != "" && tr.Side != t.conf.Side {
+			continue
+		}
+
+		if large == nil || tr.Amount.GreaterThan(large.Amount) {
+			large = &tr
+		}
+	}
+
+	return large
+}
+
+func (t *TradeFlow) duration(sec int) time.Duration {
+	return time.Duration(sec) * time.Second
+}
+
+func (t *TradeFlow) CandlesCount() int {
+	return 0
+}
+
+func (t *TradeFlow) TradesWindow() time.Duration {
+	if t.conf.Metric == metricBurst {
+		return t.duration(t.conf.Lookback)
+	}
+
+	return t.duration(t.conf.Window)
+}
+
+func (t *TradeFlow) Snapshot() tools.Snapshot {
+	return t.snapshot.Get()
+}
+
+func (t *TradeFlow) Reset() {}
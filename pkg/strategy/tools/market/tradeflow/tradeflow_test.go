@@ -0,0 +1,347 @@
+package tradeflow
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func level(val int64) tools.Level {
+	l := tools.Level{LevelVal: decimal.New(val, 0)}
+	l.Init(decimal.New(-100, 0), decimal.New(100, 0))
+	return l
+}
+
+func trade(ago int, side string, amount int64) exchange.Trade {
+	return exchange.Trade{
+		Timestamp: now.Add(-time.Duration(ago) * time.Second),
+		Rate:      decimal.New(100, 0),
+		Amount:    decimal.New(amount, 0),
+		Side:      side,
+	}
+}
+
+func TestTradeFlowNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		Window      time.Duration
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation with default values",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Metric = metricDelta
+				return nil
+			},
+			Window:      defaultWindow * time.Second,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful creation with default lookback",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Metric = metricBurst
+				val.Window = 60
+				return nil
+			},
+			Window:      600 * time.Second,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Window, res.TradesWindow())
+		})
+	}
+}
+
+func TestTradeFlowValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when window is invalid",
+			Settings: settings{
+				Metric: metricDelta,
+				Window: 86401,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(-50),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when metric is invalid",
+			Settings: settings{
+				Metric: "test",
+				Window: 60,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(-50),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when lookback is invalid",
+			Settings: settings{
+				Metric:   metricBurst,
+				Window:   60,
+				Lookback: 100,
+				Cond:     tools.Cond{C: tools.CondAbove},
+				Level:    level(3),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when min amount is invalid",
+			Settings: settings{
+				Metric: metricLarge,
+				Window: 60,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when side is invalid",
+			Settings: settings{
+				Metric:    metricLarge,
+				Window:    60,
+				MinAmount: decimal.New(10, 0),
+				Side:      "test",
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond is invalid",
+			Settings: settings{
+				Metric: metricDelta,
+				Window: 60,
+				Cond:   tools.Cond{C: "test"},
+				Level:  level(-50),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Level is invalid",
+			Settings: settings{
+				Metric: metricDelta,
+				Window: 60,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(-100),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation with delta metric",
+			Settings: settings{
+				Metric: metricDelta,
+				Window: 60,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(-50),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation with burst metric",
+			Settings: settings{
+				Metric:   metricBurst,
+				Window:   60,
+				Lookback: 600,
+				Cond:     tools.Cond{C: tools.CondAbove},
+				Level:    level(3),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation with large metric",
+			Settings: settings{
+				Metric:    metricLarge,
+				Window:    60,
+				MinAmount: decimal.New(10, 0),
+				Side:      exchange.OrderSideSell,
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := TradeFlow{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestTradeFlowConditionsMet(t *testing.T) {
+	data := exchange.Data{
+		Trades: []exchange.Trade{
+			trade(500, exchange.OrderSideBuy, 1),
+			trade(300, exchange.OrderSideSell, 2),
+			trade(100, exchange.OrderSideBuy, 1),
+			trade(50, exchange.OrderSideBuy, 3),
+			trade(30, exchange.OrderSideSell, 1),
+			trade(10, exchange.OrderSideBuy, 20),
+		},
+	}
+
+	tests := []struct {
+		Name        string
+		Settings    settings
+		Value       string
+		LargeTrade  *exchange.Trade
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when metric is invalid",
+			Settings:    settings{Metric: "test", Window: 60},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when delta is above the level",
+			Settings: settings{
+				Metric: metricDelta,
+				Window: 60,
+				Cond:   tools.Cond{C: tools.CondAbove},
+				Level:  level(50),
+			},
+			Value:       "91.67",
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when delta is not below the level",
+			Settings: settings{
+				Metric: metricDelta,
+				Window: 400,
+				Cond:   tools.Cond{C: tools.CondBelow},
+				Level:  level(-50),
+			},
+			Value:       "77.78",
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when burst ratio is above the level",
+			Settings: settings{
+				Metric:   metricBurst,
+				Window:   60,
+				Lookback: 600,
+				Cond:     tools.Cond{C: tools.CondAbove},
+				Level:    level(5),
+			},
+			Value:       "9.00",
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when burst ratio is not above the level",
+			Settings: settings{
+				Metric:   metricBurst,
+				Window:   60,
+				Lookback: 120,
+				Cond:     tools.Cond{C: tools.CondAbove},
+				Level:    level(5),
+			},
+			Value:       "3.00",
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when large trade is found",
+			Settings: settings{
+				Metric:    metricLarge,
+				Window:    60,
+				MinAmount: decimal.New(3, 0),
+			},
+			LargeTrade:  &data.Trades[5],
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when large trade is not found on the side",
+			Settings: settings{
+				Metric:    metricLarge,
+				Window:    60,
+				MinAmount: decimal.New(2, 0),
+				Side:      exchange.OrderSideSell,
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := &TradeFlow{
+				conf: v.Settings,
+				now: func() time.Time {
+					return now
+				},
+			}
+
+			res, err := obj.ConditionsMet(data)
+			assert.Equal(t, v.Result, res)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				assert.Equal(t, tools.Snapshot{}, obj.Snapshot())
+				return
+			}
+
+			assert.Nil(t, err)
+			snap := obj.Snapshot()
+			assert.Equal(t, v.Result, snap.CondsMet)
+
+			data := snap.Data.(snapshot)
+			switch v.Settings.Metric {
+			case metricDelta:
+				assert.Equal(t, v.Value, data.Delta.StringFixed(2))
+			case metricBurst:
+				assert.Equal(t, v.Value, data.BurstRatio.StringFixed(2))
+			case metricLarge:
+				assert.Equal(t, v.LargeTrade, data.LargeTrade)
+			}
+		})
+	}
+}
+
+func TestTradeFlowCandlesCount(t *testing.T) {
+	obj := TradeFlow{conf: settings{Metric: metricLarge, Window: 60}}
+	assert.Equal(t, 0, obj.CandlesCount())
+	assert.Equal(t, time.Minute, obj.TradesWindow())
+}
@@ -0,0 +1,312 @@
+package divergence
+
+import (
+	"encoding/json"
+	"eonbot/pkg/exchange"
+	indiMACD "eonbot/pkg/strategy/indicators/macd"
+	indiRSI "eonbot/pkg/strategy/indicators/rsi"
+	indiStoch "eonbot/pkg/strategy/indicators/stoch"
+	indiSwing "eonbot/pkg/strategy/indicators/swing"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"time"
+
+	"github.com/leebenson/conform"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	oscRSI   = "rsi"
+	oscMACD  = "macd"
+	oscStoch = "stoch"
+
+	regularBullish = "regularbullish"
+	hiddenBullish  = "hiddenbullish"
+	regularBearish = "regularbearish"
+	hiddenBearish  = "hiddenbearish"
+
+	defaultLookback = 50
+	defaultStrength = 2
+	maxLookback     = 200
+	maxStrength     = 10
+)
+
+type Divergence struct {
+	// oscs contains oscillator objects, index of each
+	// element specifies its offset (candles back from the latest).
+	oscs []oscillator
+
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Osc specifies which oscillator should be compared
+	// with price: rsi, macd or stoch.
+	Osc string `json:"osc" conform:"trim,lower"`
+
+	// OscConf specifies oscillator's settings.
+	OscConf json.RawMessage `json:"oscConf"`
+
+	// Divergence specifies which divergence should be detected:
+	// regularBullish, hiddenBullish, regularBearish or hiddenBearish.
+	Divergence string `json:"divergence" conform:"trim,lower"`
+
+	// Lookback specifies how many latest candles
+	// should be searched for swing highs and lows.
+	Lookback int `json:"lookback"`
+
+	// Strength specifies how many candles on each side
+	// of the swing point must have lower highs (or higher lows).
+	Strength int `json:"strength"`
+}
+
+type snapshot struct {
+	// Previous and Latest specify the two latest swing points
+	// compared (nil if less than two swing points were found).
+	Previous *pivot `json:"previous,omitempty"`
+	Latest   *pivot `json:"latest,omitempty"`
+}
+
+// pivot contains price and oscillator
+// values of a single swing point.
+type pivot struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Price     decimal.Decimal `json:"price"`
+	OscVal    decimal.Decimal `json:"oscVal"`
+}
+
+func New(conf func(v interface{}) error) (*Divergence, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if s.Lookback == 0 {
+		s.Lookback = defaultLookback
+	}
+
+	if s.Strength == 0 {
+		s.Strength = defaultStrength
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	create, err := s.oscillatorFactory()
+	if err != nil {
+		return nil, err
+	}
+
+	oscs := make([]oscillator, 0, s.Lookback)
+	for i := 0; i < s.Lookback; i++ {
+		osc, err := create(i)
+		if err != nil {
+			return nil, err
+		}
+
+		oscs = append(oscs, osc)
+	}
+
+	return &Divergence{
+		oscs: oscs,
+		conf: s,
+	}, nil
+}
+
+func (s settings) validate() error {
+	switch s.Divergence {
+	case regularBullish, hiddenBullish, regularBearish, hiddenBearish:
+		break
+	default:
+		return errors.New("divergence is invalid")
+	}
+
+	if s.Strength < 1 || s.Strength > maxStrength {
+		return errors.New("strength must be between 1 and 10 (inclusively)")
+	}
+
+	if s.Lookback < s.Strength*4+2 || s.Lookback > maxLookback {
+		return errors.New("lookback must be at least 4 times the strength plus 2 and not exceed 200")
+	}
+
+	if _, err := s.oscillatorFactory(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// oscillatorFactory parses and validates oscillator's settings
+// and returns a function that creates oscillator with the
+// provided offset.
+func (s settings) oscillatorFactory() (func(offset int) (oscillator, error), error) {
+	switch s.Osc {
+	case oscRSI:
+		var conf indiRSI.RSIConfig
+		if err := s.parseOscConf(&conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return func(offset int) (oscillator, error) {
+			rsi, err := indiRSI.NewFromConfig(conf, offset)
+			if err != nil {
+				return nil, err
+			}
+			return rsiOsc{rsi}, nil
+		}, nil
+	case oscMACD:
+		var conf indiMACD.MACDConfig
+		if err := s.parseOscConf(&conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return func(offset int) (oscillator, error) {
+			macd, err := indiMACD.NewFromConfig(conf, offset)
+			if err != nil {
+				return nil, err
+			}
+			return macdOsc{macd}, nil
+		}, nil
+	case oscStoch:
+		var conf indiStoch.StochConfig
+		if err := s.parseOscConf(&conf); err != nil {
+			return nil, err
+		}
+
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+
+		return func(offset int) (oscillator, error) {
+			stoch, err := indiStoch.NewFromConfig(conf, offset)
+			if err != nil {
+				return nil, err
+			}
+			return stochOsc{stoch}, nil
+		}, nil
+	default:
+		return nil, errors.New("oscillator is invalid")
+	}
+}
+
+func (s settings) parseOscConf(v interface{}) error {
+	if err := json.Unmarshal(s.OscConf, v); err != nil {
+		return err
+	}
+
+	conform.Strings(v)
+	return nil
+}
+
+func (d *Divergence) Validate() error {
+	return d.conf.validate()
+}
+
+func (d *Divergence) ConditionsMet(data exchange.Data) (bool, error) {
+	if len(data.Candles) < d.conf.Lookback {
+		d.snapshot.Clear()
+		return false, errors.New("candles list size is too small")
+	}
+
+	candles := data.Candles[len(data.Candles)-d.conf.Lookback:]
+	bullish := d.conf.Divergence == regularBullish || d.conf.Divergence == hiddenBullish
+
+	// collect indexes of the two latest swing points,
+	// the latest one goes first.
+	points := make([]int, 0, 2)
+	for i := len(candles) - d.conf.Strength - 1; i >= d.conf.Strength && len(points) < 2; i-- {
+		if bullish && indiSwing.IsLow(candles, i, d.conf.Strength) ||
+			!bullish && indiSwing.IsHigh(candles, i, d.conf.Strength) {
+			points = append(points, i)
+		}
+	}
+
+	if len(points) < 2 {
+		// collect snapshot data
+		d.snapshot.Set(snapshot{}, false)
+		return false, nil
+	}
+
+	latest, err := d.pivot(data.Candles, candles, points[0], bullish)
+	if err != nil {
+		d.snapshot.Clear()
+		return false, err
+	}
+
+	previous, err := d.pivot(data.Candles, candles, points[1], bullish)
+	if err != nil {
+		d.snapshot.Clear()
+		return false, err
+	}
+
+	isMet := false
+	switch d.conf.Divergence {
+	case regularBullish:
+		isMet = latest.Price.LessThan(previous.Price) && latest.OscVal.GreaterThan(previous.OscVal)
+	case hiddenBullish:
+		isMet = latest.Price.GreaterThan(previous.Price) && latest.OscVal.LessThan(previous.OscVal)
+	case regularBearish:
+		isMet = latest.Price.GreaterThan(previous.Price) && latest.OscVal.LessThan(previous.OscVal)
+	case hiddenBearish:
+		isMet = latest.Price.LessThan(previous.Price) && latest.OscVal.GreaterThan(previous.OscVal)
+	default:
+		d.snapshot.Clear()
+		return false, errors.New("divergence is invalid")
+	}
+
+	// collect snapshot data
+	d.snapshot.Set(snapshot{Previous: &previous, Latest: &latest}, isMet)
+	return isMet, nil
+}
+
+// pivot calculates price and oscillator values of the swing
+// point at the specified index of lookback candles.
+func (d *Divergence) pivot(all, candles []exchange.Candle, i int, bullish bool) (pivot, error) {
+	offset := len(candles) - i - 1
+	if offset >= len(d.oscs) {
+		return pivot{}, errors.New("oscillator is not initialized")
+	}
+
+	val, err := d.oscs[offset].Value(all)
+	if err != nil {
+		return pivot{}, err
+	}
+
+	price := candles[i].High
+	if bullish {
+		price = candles[i].Low
+	}
+
+	return pivot{
+		Timestamp: candles[i].Timestamp,
+		Price:     price,
+		OscVal:    val,
+	}, nil
+}
+
+func (d *Divergence) CandlesCount() int {
+	count := d.conf.Lookback
+	for _, osc := range d.oscs {
+		if osc.CandlesCount() > count {
+			count = osc.CandlesCount()
+		}
+	}
+
+	return count
+}
+
+func (d *Divergence) Snapshot() tools.Snapshot {
+	return d.snapshot.Get()
+}
+
+func (d *Divergence) Reset() {}
@@ -0,0 +1,387 @@
+package divergence
+
+import (
+	"encoding/json"
+	"eonbot/pkg/exchange"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+type oscMock struct {
+	val   decimal.Decimal
+	err   error
+	count int
+}
+
+func (o oscMock) CandlesCount() int {
+	return o.count
+}
+
+func (o oscMock) Value(cc []exchange.Candle) (decimal.Decimal, error) {
+	return o.val, o.err
+}
+
+// oscs creates oscillator mocks list with the specified
+// values at the specified offsets.
+func oscs(size int, vals map[int]int64) []oscillator {
+	res := make([]oscillator, size)
+	for i := range res {
+		res[i] = oscMock{val: decimal.New(vals[i], 0), count: i + 1}
+	}
+	return res
+}
+
+// candles creates candles list from provided lows,
+// highs are always 2 units above lows.
+func candles(lows ...int64) []exchange.Candle {
+	res := make([]exchange.Candle, 0, len(lows))
+	for _, l := range lows {
+		res = append(res, exchange.Candle{
+			Low:  decimal.New(l, 0),
+			High: decimal.New(l+2, 0),
+		})
+	}
+	return res
+}
+
+func TestDivergenceNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		Count       int
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when settings are invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Osc = oscRSI
+				val.OscConf = json.RawMessage(`{"period":14,"price":"close"}`)
+				val.Divergence = "test"
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation with RSI",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Osc = oscRSI
+				val.OscConf = json.RawMessage(`{"period":14,"price":"CLOSE"}`)
+				val.Divergence = regularBullish
+				return nil
+			},
+			Count:       77,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful creation with MACD",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Osc = oscMACD
+				val.OscConf = json.RawMessage(`{"ema1Period":12,"ema2Period":26,"signalPeriod":9,"price":"close"}`)
+				val.Divergence = hiddenBearish
+				val.Lookback = 30
+				val.Strength = 3
+				return nil
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful creation with Stoch",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Osc = oscStoch
+				val.OscConf = json.RawMessage(`{"KPeriod":14,"DPeriod":3}`)
+				val.Divergence = regularBearish
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, res.conf.Lookback, len(res.oscs))
+			if v.Count > 0 {
+				assert.Equal(t, v.Count, res.CandlesCount())
+			}
+		})
+	}
+}
+
+func TestDivergenceValidate(t *testing.T) {
+	rsiConf := json.RawMessage(`{"period":14,"price":"close"}`)
+
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when divergence is invalid",
+			Settings: settings{
+				Osc:        oscRSI,
+				OscConf:    rsiConf,
+				Divergence: "test",
+				Lookback:   50,
+				Strength:   2,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when strength is invalid",
+			Settings: settings{
+				Osc:        oscRSI,
+				OscConf:    rsiConf,
+				Divergence: regularBullish,
+				Lookback:   50,
+				Strength:   11,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when lookback is too small",
+			Settings: settings{
+				Osc:        oscRSI,
+				OscConf:    rsiConf,
+				Divergence: regularBullish,
+				Lookback:   9,
+				Strength:   2,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when lookback is too big",
+			Settings: settings{
+				Osc:        oscRSI,
+				OscConf:    rsiConf,
+				Divergence: regularBullish,
+				Lookback:   201,
+				Strength:   2,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when oscillator is invalid",
+			Settings: settings{
+				Osc:        "test",
+				OscConf:    rsiConf,
+				Divergence: regularBullish,
+				Lookback:   50,
+				Strength:   2,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when oscillator config is malformed",
+			Settings: settings{
+				Osc:        oscRSI,
+				OscConf:    json.RawMessage(`{"period":"test"}`),
+				Divergence: regularBullish,
+				Lookback:   50,
+				Strength:   2,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when oscillator config is invalid",
+			Settings: settings{
+				Osc:        oscMACD,
+				OscConf:    json.RawMessage(`{"ema1Period":12,"ema2Period":26,"signalPeriod":9,"price":"test"}`),
+				Divergence: regularBullish,
+				Lookback:   50,
+				Strength:   2,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Osc:        oscStoch,
+				OscConf:    json.RawMessage(`{"KPeriod":14,"DPeriod":3}`),
+				Divergence: hiddenBullish,
+				Lookback:   10,
+				Strength:   2,
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := Divergence{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestDivergenceConditionsMet(t *testing.T) {
+	// swing lows at indexes 2 (8) and 7 (7), i.e. offsets 8 and 3.
+	lowerLows := candles(10, 9, 8, 9, 10, 9, 8, 7, 8, 9, 10)
+
+	// swing highs at indexes 2 (12) and 7 (13), i.e. offsets 8 and 3.
+	higherHighs := candles(8, 9, 10, 9, 8, 9, 10, 11, 10, 9, 8)
+
+	tests := []struct {
+		Name        string
+		Tool        *Divergence
+		Data        exchange.Data
+		Snapshot    snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when candles list is too small",
+			Tool:        &Divergence{conf: settings{Divergence: regularBullish, Lookback: 12, Strength: 2}},
+			Data:        exchange.Data{Candles: lowerLows},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when oscillator returns error",
+			Tool: &Divergence{
+				oscs: []oscillator{
+					oscMock{}, oscMock{}, oscMock{}, oscMock{err: errors.New("test")},
+				},
+				conf: settings{Divergence: regularBullish, Lookback: 11, Strength: 2},
+			},
+			Data:        exchange.Data{Candles: lowerLows},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when divergence is invalid",
+			Tool: &Divergence{
+				oscs: oscs(11, map[int]int64{3: 40, 8: 30}),
+				conf: settings{Divergence: "test", Lookback: 11, Strength: 2},
+			},
+			Data:        exchange.Data{Candles: higherHighs},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when less than two swing points are found",
+			Tool: &Divergence{
+				oscs: oscs(11, map[int]int64{3: 40, 8: 30}),
+				conf: settings{Divergence: regularBearish, Lookback: 11, Strength: 2},
+			},
+			Data:        exchange.Data{Candles: lowerLows},
+			Snapshot:    snapshot{},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when regular bullish divergence is found",
+			Tool: &Divergence{
+				oscs: oscs(11, map[int]int64{3: 40, 8: 30}),
+				conf: settings{Divergence: regularBullish, Lookback: 11, Strength: 2},
+			},
+			Data: exchange.Data{Candles: lowerLows},
+			Snapshot: snapshot{
+				Previous: &pivot{Price: decimal.New(8, 0), OscVal: decimal.New(30, 0)},
+				Latest:   &pivot{Price: decimal.New(7, 0), OscVal: decimal.New(40, 0)},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when hidden bullish divergence is not found",
+			Tool: &Divergence{
+				oscs: oscs(11, map[int]int64{3: 40, 8: 30}),
+				conf: settings{Divergence: hiddenBullish, Lookback: 11, Strength: 2},
+			},
+			Data: exchange.Data{Candles: lowerLows},
+			Snapshot: snapshot{
+				Previous: &pivot{Price: decimal.New(8, 0), OscVal: decimal.New(30, 0)},
+				Latest:   &pivot{Price: decimal.New(7, 0), OscVal: decimal.New(40, 0)},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when regular bearish divergence is found",
+			Tool: &Divergence{
+				oscs: oscs(11, map[int]int64{3: 60, 8: 70}),
+				conf: settings{Divergence: regularBearish, Lookback: 11, Strength: 2},
+			},
+			Data: exchange.Data{Candles: higherHighs},
+			Snapshot: snapshot{
+				Previous: &pivot{Price: decimal.New(12, 0), OscVal: decimal.New(70, 0)},
+				Latest:   &pivot{Price: decimal.New(13, 0), OscVal: decimal.New(60, 0)},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when hidden bearish divergence is not found",
+			Tool: &Divergence{
+				oscs: oscs(11, map[int]int64{3: 60, 8: 70}),
+				conf: settings{Divergence: hiddenBearish, Lookback: 11, Strength: 2},
+			},
+			Data: exchange.Data{Candles: higherHighs},
+			Snapshot: snapshot{
+				Previous: &pivot{Price: decimal.New(12, 0), OscVal: decimal.New(70, 0)},
+				Latest:   &pivot{Price: decimal.New(13, 0), OscVal: decimal.New(60, 0)},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			snap := v.Tool.Snapshot()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				assert.Nil(t, snap.Data)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, v.Result, snap.CondsMet)
+			assert.Equal(t, v.Snapshot, snap.Data)
+		})
+	}
+}
+
+func TestDivergenceCandlesCount(t *testing.T) {
+	obj := Divergence{
+		oscs: oscs(11, nil),
+		conf: settings{Lookback: 11},
+	}
+	assert.Equal(t, 11, obj.CandlesCount())
+
+	obj.oscs = append(obj.oscs, oscMock{count: 30})
+	assert.Equal(t, 30, obj.CandlesCount())
+}
@@ -0,0 +1,51 @@
+package divergence
+
+import (
+	"eonbot/pkg/exchange"
+	indiMACD "eonbot/pkg/strategy/indicators/macd"
+	indiRSI "eonbot/pkg/strategy/indicators/rsi"
+	indiStoch "eonbot/pkg/strategy/indicators/stoch"
+
+	"github.com/shopspring/decimal"
+)
+
+// oscillator wraps supported indicators so that
+// each of them would return a single value.
+type oscillator interface {
+	CandlesCount() int
+	Value(cc []exchange.Candle) (decimal.Decimal, error)
+}
+
+type rsiOsc struct {
+	indiRSI.RSI
+}
+
+func (r rsiOsc) Value(cc []exchange.Candle) (decimal.Decimal, error) {
+	return r.Calc(cc)
+}
+
+// macdOsc uses MACD line values.
+type macdOsc struct {
+	indiMACD.MACD
+}
+
+func (m macdOsc) Value(cc []exchange.Candle) (decimal.Decimal, error) {
+	info, err := m.Calc(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return info.MACDLine, nil
+}
+
+// stochOsc uses K line values.
+type stochOsc struct {
+	indiStoch.Stoch
+}
+
+func (s stochOsc) Value(cc []exchange.Candle) (decimal.Decimal, error) {
+	info, err := s.Calc(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return info.K, nil
+}
@@ -0,0 +1,119 @@
+package candlepattern
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	maxOffset = 200
+)
+
+var (
+	defaultWickRatio = decimal.New(2, 0)
+)
+
+type CandlePattern struct {
+	pattern  pattern
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Pattern specifies which candlestick pattern
+	// must be formed.
+	Pattern string `json:"pattern" conform:"trim,lower"`
+
+	// Offset specifies how many candles before the latest one
+	// the last candle of the pattern should be.
+	Offset int `json:"offset"`
+
+	// BodyRatio specifies max body to range ratio of a
+	// small-bodied candle (doji, middle candle of stars).
+	BodyRatio decimal.Decimal `json:"bodyRatio"`
+
+	// WickRatio specifies how many times the long wick
+	// must be bigger than the body (hammer, shooting star).
+	WickRatio decimal.Decimal `json:"wickRatio"`
+}
+
+type snapshot struct {
+	// Candles contains candles that were checked,
+	// the last one being the latest.
+	Candles []exchange.Candle `json:"candles"`
+}
+
+func New(conf func(v interface{}) error) (*CandlePattern, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	p, ok := patterns[s.Pattern]
+	if !ok {
+		return nil, errors.New("pattern is invalid")
+	}
+
+	if s.BodyRatio.IsZero() {
+		s.BodyRatio = p.bodyRatio
+	}
+
+	if s.WickRatio.IsZero() {
+		s.WickRatio = defaultWickRatio
+	}
+
+	return &CandlePattern{
+		pattern: p,
+		conf:    s,
+	}, nil
+}
+
+func (c *CandlePattern) Validate() error {
+	if _, ok := patterns[c.conf.Pattern]; !ok {
+		return errors.New("pattern is invalid")
+	}
+
+	if c.conf.Offset < 0 || c.conf.Offset > maxOffset {
+		return errors.New("offset must be between 0 and 200 (inclusively)")
+	}
+
+	if c.conf.BodyRatio.IsNegative() || c.conf.BodyRatio.GreaterThan(decimal.New(1, 0)) {
+		return errors.New("body ratio must be between 0 and 1 (inclusively)")
+	}
+
+	if c.conf.WickRatio.IsNegative() {
+		return errors.New("wick ratio cannot be negative")
+	}
+
+	return nil
+}
+
+func (c *CandlePattern) ConditionsMet(d exchange.Data) (bool, error) {
+	count := c.CandlesCount()
+	if len(d.Candles) < count {
+		c.snapshot.Clear()
+		return false, errors.New("candles list size is too small")
+	}
+
+	end := len(d.Candles) - c.conf.Offset
+	cc := make([]exchange.Candle, c.pattern.candles)
+	copy(cc, d.Candles[end-c.pattern.candles:end])
+	isMet := c.pattern.detect(cc, c.conf)
+
+	// collect snapshot data
+	c.snapshot.Set(snapshot{Candles: cc}, isMet)
+	return isMet, nil
+}
+
+func (c *CandlePattern) CandlesCount() int {
+	return c.pattern.candles + c.conf.Offset
+}
+
+func (c *CandlePattern) Snapshot() tools.Snapshot {
+	return c.snapshot.Get()
+}
+
+func (c *CandlePattern) Reset() {}
@@ -0,0 +1,234 @@
+package candlepattern
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandlePatternNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when pattern is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Pattern = "test"
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Pattern = morningStar
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCandlePatternNewDefaults(t *testing.T) {
+	c, err := New(func(v interface{}) error {
+		val := v.(*settings)
+		val.Pattern = doji
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, decimal.RequireFromString("0.1"), c.conf.BodyRatio)
+	assert.Equal(t, defaultWickRatio, c.conf.WickRatio)
+
+	c, err = New(func(v interface{}) error {
+		val := v.(*settings)
+		val.Pattern = doji
+		val.BodyRatio = decimal.RequireFromString("0.05")
+		val.WickRatio = decimal.New(3, 0)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, decimal.RequireFromString("0.05"), c.conf.BodyRatio)
+	assert.Equal(t, decimal.New(3, 0), c.conf.WickRatio)
+}
+
+func TestCandlePatternValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful validation when pattern is invalid",
+			Settings:    settings{Pattern: "test"},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when offset is negative",
+			Settings:    settings{Pattern: doji, Offset: -1},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when offset is too big",
+			Settings:    settings{Pattern: doji, Offset: 201},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when body ratio is negative",
+			Settings:    settings{Pattern: doji, BodyRatio: decimal.New(-1, 0)},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when body ratio is above 1",
+			Settings:    settings{Pattern: doji, BodyRatio: decimal.RequireFromString("1.1")},
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful validation when wick ratio is negative",
+			Settings:    settings{Pattern: hammer, WickRatio: decimal.New(-1, 0)},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Pattern:   hammer,
+				Offset:    200,
+				BodyRatio: decimal.RequireFromString("0.1"),
+				WickRatio: decimal.New(2, 0),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := CandlePattern{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCandlePatternConditionsMet(t *testing.T) {
+	bearish := candle("11", "11.5", "9.5", "10")
+	bullish := candle("9.8", "12.5", "9.5", "12")
+	dojiCandle := candle("10", "11", "9", "10.1")
+
+	tool := func(name string, offset int) *CandlePattern {
+		return &CandlePattern{
+			pattern: patterns[name],
+			conf: settings{
+				Pattern:   name,
+				Offset:    offset,
+				BodyRatio: patterns[name].bodyRatio,
+				WickRatio: defaultWickRatio,
+			},
+		}
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *CandlePattern
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when there are not enough candles",
+			Tool:        tool(bullishEngulfing, 1),
+			Data:        exchange.Data{Candles: []exchange.Candle{bearish, bullish}},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when pattern is formed on the latest candles",
+			Tool: tool(bullishEngulfing, 0),
+			Data: exchange.Data{Candles: []exchange.Candle{dojiCandle, bearish, bullish}},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{Candles: []exchange.Candle{bearish, bullish}},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when pattern is formed with offset",
+			Tool: tool(bullishEngulfing, 1),
+			Data: exchange.Data{Candles: []exchange.Candle{bearish, bullish, dojiCandle}},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{Candles: []exchange.Candle{bearish, bullish}},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when pattern is not formed",
+			Tool: tool(doji, 0),
+			Data: exchange.Data{Candles: []exchange.Candle{dojiCandle, bullish}},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{Candles: []exchange.Candle{bullish}},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCandlePatternCandlesCount(t *testing.T) {
+	obj := CandlePattern{pattern: patterns[morningStar]}
+	assert.Equal(t, 3, obj.CandlesCount())
+
+	obj.conf.Offset = 2
+	assert.Equal(t, 5, obj.CandlesCount())
+}
@@ -0,0 +1,187 @@
+package candlepattern
+
+import (
+	"eonbot/pkg/exchange"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	doji             = "doji"
+	hammer           = "hammer"
+	shootingStar     = "shootingstar"
+	bullishEngulfing = "bullishengulfing"
+	bearishEngulfing = "bearishengulfing"
+	morningStar      = "morningstar"
+	eveningStar      = "eveningstar"
+	threeSoldiers    = "threesoldiers"
+	threeCrows       = "threecrows"
+)
+
+type pattern struct {
+	// candles specifies how many candles
+	// the pattern consists of.
+	candles int
+
+	// bodyRatio specifies default max body to
+	// range ratio of a small-bodied candle.
+	bodyRatio decimal.Decimal
+
+	// detect checks whether the passed candles (the
+	// last one being the latest) form the pattern.
+	detect func(cc []exchange.Candle, conf settings) bool
+}
+
+var patterns = map[string]pattern{
+	doji: {
+		candles:   1,
+		bodyRatio: decimal.RequireFromString("0.1"),
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			return isSmall(cc[0], conf.BodyRatio)
+		},
+	},
+	hammer: {
+		candles: 1,
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			c := cc[0]
+			return candleRange(c).IsPositive() &&
+				lowerWick(c).GreaterThanOrEqual(body(c).Mul(conf.WickRatio)) &&
+				upperWick(c).LessThanOrEqual(body(c))
+		},
+	},
+	shootingStar: {
+		candles: 1,
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			c := cc[0]
+			return candleRange(c).IsPositive() &&
+				upperWick(c).GreaterThanOrEqual(body(c).Mul(conf.WickRatio)) &&
+				lowerWick(c).LessThanOrEqual(body(c))
+		},
+	},
+	bullishEngulfing: {
+		candles: 2,
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			prev, curr := cc[0], cc[1]
+			return isBearish(prev) && isBullish(curr) &&
+				curr.Open.LessThanOrEqual(prev.Close) &&
+				curr.Close.GreaterThanOrEqual(prev.Open) &&
+				body(curr).GreaterThan(body(prev))
+		},
+	},
+	bearishEngulfing: {
+		candles: 2,
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			prev, curr := cc[0], cc[1]
+			return isBullish(prev) && isBearish(curr) &&
+				curr.Open.GreaterThanOrEqual(prev.Close) &&
+				curr.Close.LessThanOrEqual(prev.Open) &&
+				body(curr).GreaterThan(body(prev))
+		},
+	},
+	morningStar: {
+		candles:   3,
+		bodyRatio: decimal.RequireFromString("0.3"),
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			first, star, last := cc[0], cc[1], cc[2]
+			return isBearish(first) && !isSmall(first, conf.BodyRatio) &&
+				isSmall(star, conf.BodyRatio) &&
+				decimal.Min(star.Open, star.Close).LessThanOrEqual(first.Close) &&
+				isBullish(last) && last.Close.GreaterThan(midpoint(first))
+		},
+	},
+	eveningStar: {
+		candles:   3,
+		bodyRatio: decimal.RequireFromString("0.3"),
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			first, star, last := cc[0], cc[1], cc[2]
+			return isBullish(first) && !isSmall(first, conf.BodyRatio) &&
+				isSmall(star, conf.BodyRatio) &&
+				decimal.Max(star.Open, star.Close).GreaterThanOrEqual(first.Close) &&
+				isBearish(last) && last.Close.LessThan(midpoint(first))
+		},
+	},
+	threeSoldiers: {
+		candles: 3,
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			for i, c := range cc {
+				if !isBullish(c) || upperWick(c).GreaterThan(body(c)) {
+					return false
+				}
+
+				if i == 0 {
+					continue
+				}
+
+				// every candle should open within the previous
+				// candle's body and close higher than it.
+				prev := cc[i-1]
+				if c.Open.LessThan(prev.Open) || c.Open.GreaterThan(prev.Close) ||
+					c.Close.LessThanOrEqual(prev.Close) {
+					return false
+				}
+			}
+
+			return true
+		},
+	},
+	threeCrows: {
+		candles: 3,
+		detect: func(cc []exchange.Candle, conf settings) bool {
+			for i, c := range cc {
+				if !isBearish(c) || lowerWick(c).GreaterThan(body(c)) {
+					return false
+				}
+
+				if i == 0 {
+					continue
+				}
+
+				// every candle should open within the previous
+				// candle's body and close lower than it.
+				prev := cc[i-1]
+				if c.Open.GreaterThan(prev.Open) || c.Open.LessThan(prev.Close) ||
+					c.Close.GreaterThanOrEqual(prev.Close) {
+					return false
+				}
+			}
+
+			return true
+		},
+	},
+}
+
+func body(c exchange.Candle) decimal.Decimal {
+	return c.Close.Sub(c.Open).Abs()
+}
+
+func candleRange(c exchange.Candle) decimal.Decimal {
+	return c.High.Sub(c.Low)
+}
+
+func upperWick(c exchange.Candle) decimal.Decimal {
+	return c.High.Sub(decimal.Max(c.Open, c.Close))
+}
+
+func lowerWick(c exchange.Candle) decimal.Decimal {
+	return decimal.Min(c.Open, c.Close).Sub(c.Low)
+}
+
+func midpoint(c exchange.Candle) decimal.Decimal {
+	return c.Open.Add(c.Close).Div(decimal.New(2, 0))
+}
+
+func isBullish(c exchange.Candle) bool {
+	return c.Close.GreaterThan(c.Open)
+}
+
+func isBearish(c exchange.Candle) bool {
+	return c.Close.LessThan(c.Open)
+}
+
+// isSmall checks whether candle's body compared to
+// its range does not exceed the specified ratio.
+// Candles without any price movement are not considered small.
+func isSmall(c exchange.Candle, ratio decimal.Decimal) bool {
+	rng := candleRange(c)
+	return rng.IsPositive() && body(c).LessThanOrEqual(rng.Mul(ratio))
+}
@@ -0,0 +1,206 @@
+package candlepattern
+
+import (
+	"eonbot/pkg/exchange"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func candle(open, high, low, close string) exchange.Candle {
+	return exchange.Candle{
+		Open:  decimal.RequireFromString(open),
+		High:  decimal.RequireFromString(high),
+		Low:   decimal.RequireFromString(low),
+		Close: decimal.RequireFromString(close),
+	}
+}
+
+func TestPatterns(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Pattern string
+		Candles []exchange.Candle
+		Result  bool
+	}{
+		{
+			Name:    "Doji is detected",
+			Pattern: doji,
+			Candles: []exchange.Candle{candle("10", "11", "9", "10.1")},
+			Result:  true,
+		},
+		{
+			Name:    "Doji is not detected when body is too big",
+			Pattern: doji,
+			Candles: []exchange.Candle{candle("10", "11", "9", "10.5")},
+			Result:  false,
+		},
+		{
+			Name:    "Doji is not detected when there is no price movement",
+			Pattern: doji,
+			Candles: []exchange.Candle{candle("10", "10", "10", "10")},
+			Result:  false,
+		},
+		{
+			Name:    "Hammer is detected",
+			Pattern: hammer,
+			Candles: []exchange.Candle{candle("10", "11.1", "7", "11")},
+			Result:  true,
+		},
+		{
+			Name:    "Hammer is not detected when upper wick is too long",
+			Pattern: hammer,
+			Candles: []exchange.Candle{candle("10", "12.5", "7", "11")},
+			Result:  false,
+		},
+		{
+			Name:    "Hammer is not detected when lower wick is too short",
+			Pattern: hammer,
+			Candles: []exchange.Candle{candle("10", "11.1", "9", "11")},
+			Result:  false,
+		},
+		{
+			Name:    "Shooting star is detected",
+			Pattern: shootingStar,
+			Candles: []exchange.Candle{candle("11", "14", "9.9", "10")},
+			Result:  true,
+		},
+		{
+			Name:    "Shooting star is not detected when lower wick is too long",
+			Pattern: shootingStar,
+			Candles: []exchange.Candle{candle("11", "14", "8", "10")},
+			Result:  false,
+		},
+		{
+			Name:    "Bullish engulfing is detected",
+			Pattern: bullishEngulfing,
+			Candles: []exchange.Candle{
+				candle("11", "11.5", "9.5", "10"),
+				candle("9.8", "12.5", "9.5", "12"),
+			},
+			Result: true,
+		},
+		{
+			Name:    "Bullish engulfing is not detected when previous body is not engulfed",
+			Pattern: bullishEngulfing,
+			Candles: []exchange.Candle{
+				candle("11", "11.5", "9.5", "10"),
+				candle("10.2", "12.5", "9.5", "12"),
+			},
+			Result: false,
+		},
+		{
+			Name:    "Bearish engulfing is detected",
+			Pattern: bearishEngulfing,
+			Candles: []exchange.Candle{
+				candle("10", "11.5", "9.5", "11"),
+				candle("11.2", "11.5", "9", "9.5"),
+			},
+			Result: true,
+		},
+		{
+			Name:    "Bearish engulfing is not detected when previous candle is bearish",
+			Pattern: bearishEngulfing,
+			Candles: []exchange.Candle{
+				candle("11", "11.5", "9.5", "10"),
+				candle("11.2", "11.5", "9", "9.5"),
+			},
+			Result: false,
+		},
+		{
+			Name:    "Morning star is detected",
+			Pattern: morningStar,
+			Candles: []exchange.Candle{
+				candle("12", "12.2", "9.8", "10"),
+				candle("9.8", "10.2", "9.2", "9.7"),
+				candle("9.9", "11.6", "9.8", "11.5"),
+			},
+			Result: true,
+		},
+		{
+			Name:    "Morning star is not detected when last candle closes below first candle's midpoint",
+			Pattern: morningStar,
+			Candles: []exchange.Candle{
+				candle("12", "12.2", "9.8", "10"),
+				candle("9.8", "10.2", "9.2", "9.7"),
+				candle("9.9", "11", "9.8", "10.5"),
+			},
+			Result: false,
+		},
+		{
+			Name:    "Evening star is detected",
+			Pattern: eveningStar,
+			Candles: []exchange.Candle{
+				candle("10", "12.2", "9.8", "12"),
+				candle("12.2", "12.8", "11.8", "12.3"),
+				candle("12.1", "12.2", "10.4", "10.5"),
+			},
+			Result: true,
+		},
+		{
+			Name:    "Evening star is not detected when middle candle's body is too big",
+			Pattern: eveningStar,
+			Candles: []exchange.Candle{
+				candle("10", "12.2", "9.8", "12"),
+				candle("12", "13.2", "11.9", "13"),
+				candle("12.1", "12.2", "10.4", "10.5"),
+			},
+			Result: false,
+		},
+		{
+			Name:    "Three soldiers are detected",
+			Pattern: threeSoldiers,
+			Candles: []exchange.Candle{
+				candle("10", "11.1", "9.9", "11"),
+				candle("10.5", "12.1", "10.4", "12"),
+				candle("11.5", "13.1", "11.4", "13"),
+			},
+			Result: true,
+		},
+		{
+			Name:    "Three soldiers are not detected when candle opens above previous body",
+			Pattern: threeSoldiers,
+			Candles: []exchange.Candle{
+				candle("10", "11.1", "9.9", "11"),
+				candle("11.2", "12.1", "11.1", "12"),
+				candle("11.5", "13.1", "11.4", "13"),
+			},
+			Result: false,
+		},
+		{
+			Name:    "Three crows are detected",
+			Pattern: threeCrows,
+			Candles: []exchange.Candle{
+				candle("13", "13.1", "11.9", "12"),
+				candle("12.5", "12.6", "10.9", "11"),
+				candle("11.5", "11.6", "9.9", "10"),
+			},
+			Result: true,
+		},
+		{
+			Name:    "Three crows are not detected when candle closes above previous close",
+			Pattern: threeCrows,
+			Candles: []exchange.Candle{
+				candle("13", "13.1", "11.9", "12"),
+				candle("12.5", "12.6", "10.9", "11"),
+				candle("11.5", "11.6", "10.9", "11.2"),
+			},
+			Result: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			p := patterns[v.Pattern]
+			conf := settings{
+				BodyRatio: p.bodyRatio,
+				WickRatio: defaultWickRatio,
+			}
+			assert.Equal(t, p.candles, len(v.Candles))
+			assert.Equal(t, v.Result, p.detect(v.Candles, conf))
+		})
+	}
+}
@@ -27,6 +27,11 @@ func (s *Shift) CalcVal(val decimal.Decimal) (res decimal.Decimal) {
 			return s.ShiftVal
 		}
 		return decimal.Zero
+	case CalcATR:
+		if s.Calc.allowATR {
+			return ebMath.UnitsIncrease(val, s.ShiftVal.Mul(s.Calc.atrVal))
+		}
+		return decimal.Zero
 	default:
 		return decimal.Zero
 	}
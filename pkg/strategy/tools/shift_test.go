@@ -135,6 +135,34 @@ func TestShiftCalcVal(t *testing.T) {
 			Val:    decimal.RequireFromString("5"),
 			Result: decimal.RequireFromString("5.5"),
 		},
+		{
+			Name: "Unsuccessful func call when Calc type is atr, but it is not allowed",
+			Shift: Shift{
+				ShiftVal: decimal.RequireFromString("-1.5"),
+				Calc: Calc{
+					Type:   CalcATR,
+					atrVal: decimal.RequireFromString("2"),
+				},
+			},
+			Val:    decimal.RequireFromString("5"),
+			Result: decimal.Zero,
+		},
+		{
+			Name: "Successful func call when Calc type is atr",
+			Shift: Shift{
+				ShiftVal: decimal.RequireFromString("-1.5"),
+				Calc: func() Calc {
+					val := Calc{
+						Type:   CalcATR,
+						atrVal: decimal.RequireFromString("2"),
+					}
+					val.AllowATR()
+					return val
+				}(),
+			},
+			Val:    decimal.RequireFromString("5"),
+			Result: decimal.RequireFromString("2"),
+		},
 	}
 
 	for _, v := range tests {
@@ -0,0 +1,146 @@
+package adx
+
+import (
+	"eonbot/pkg/exchange"
+	indiADX "eonbot/pkg/strategy/indicators/adx"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+)
+
+const (
+	directionUp   = "up"
+	directionDown = "down"
+)
+
+type ADX struct {
+	adx      indiADX.ADX
+	prevADX  indiADX.ADX
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Direction specifies which DI line must be above
+	// the other one: +DI (up) or -DI (down). Optional.
+	Direction string `json:"direction" conform:"trim,lower"`
+
+	// Cross specifies whether DI lines must have crossed
+	// on the latest candle. Requires direction.
+	Cross bool `json:"cross"`
+
+	indiADX.ADXConfig
+	tools.Cond
+	tools.Level
+}
+
+type snapshot struct {
+	Prev *indiADX.ADXInfo `json:"prev,omitempty"`
+	indiADX.ADXInfo
+}
+
+func New(conf func(v interface{}) error) (*ADX, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	adx, err := indiADX.NewFromConfig(s.ADXConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// previous candle's values are used to
+	// detect DI lines crossing.
+	prevADX, err := indiADX.NewFromConfig(s.ADXConfig, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Level.ZeroToHundred()
+
+	return &ADX{
+		adx:     adx,
+		prevADX: prevADX,
+		conf:    s,
+	}, nil
+}
+
+func (a *ADX) Validate() error {
+	switch a.conf.Direction {
+	case "", directionUp, directionDown:
+		break
+	default:
+		return errors.New("direction is invalid")
+	}
+
+	if a.conf.Cross && a.conf.Direction == "" {
+		return errors.New("cross cannot be used without direction")
+	}
+
+	if err := a.conf.ADXConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.conf.Level.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *ADX) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := a.adx.Calc(d.Candles)
+	if err != nil {
+		a.snapshot.Clear()
+		return false, err
+	}
+
+	snap := snapshot{ADXInfo: info}
+	isMet := a.conf.Cond.Match(info.ADX, a.conf.Level.LevelVal) && a.directionMet(info)
+
+	if a.conf.Cross {
+		prev, err := a.prevADX.Calc(d.Candles)
+		if err != nil {
+			a.snapshot.Clear()
+			return false, err
+		}
+
+		snap.Prev = &prev
+		isMet = isMet && !a.directionMet(prev)
+	}
+
+	// collect snapshot data
+	a.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+// directionMet checks whether DI lines are positioned
+// according to the specified direction.
+func (a *ADX) directionMet(info indiADX.ADXInfo) bool {
+	switch a.conf.Direction {
+	case directionUp:
+		return info.PlusDI.GreaterThan(info.MinusDI)
+	case directionDown:
+		return info.MinusDI.GreaterThan(info.PlusDI)
+	default:
+		return true
+	}
+}
+
+func (a *ADX) CandlesCount() int {
+	if a.conf.Cross {
+		return a.prevADX.CandlesCount()
+	}
+
+	return a.adx.CandlesCount()
+}
+
+func (a *ADX) Snapshot() tools.Snapshot {
+	return a.snapshot.Get()
+}
+
+func (a *ADX) Reset() {}
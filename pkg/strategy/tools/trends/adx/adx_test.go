@@ -0,0 +1,295 @@
+package adx
+
+import (
+	"eonbot/pkg/exchange"
+	indiADX "eonbot/pkg/strategy/indicators/adx"
+	"eonbot/pkg/strategy/indicators/all_mocks/adx_mock"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func level(val int64) tools.Level {
+	l := tools.Level{LevelVal: decimal.New(val, 0)}
+	l.ZeroToHundred()
+	return l
+}
+
+func TestADXNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when ADXConfig is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.ADXConfig = indiADX.ADXConfig{Period: 14}
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestADXValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when direction is invalid",
+			Settings: settings{
+				Direction: "test",
+				ADXConfig: indiADX.ADXConfig{Period: 14},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level(25),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when cross is used without direction",
+			Settings: settings{
+				Cross:     true,
+				ADXConfig: indiADX.ADXConfig{Period: 14},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level(25),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when ADXConfig is invalid",
+			Settings: settings{
+				ADXConfig: indiADX.ADXConfig{},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level(25),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				ADXConfig: indiADX.ADXConfig{Period: 14},
+				Cond:      tools.Cond{},
+				Level:     level(25),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Level is invalid",
+			Settings: settings{
+				ADXConfig: indiADX.ADXConfig{Period: 14},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level(120),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation without direction",
+			Settings: settings{
+				ADXConfig: indiADX.ADXConfig{Period: 14},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level(25),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Direction: directionUp,
+				Cross:     true,
+				ADXConfig: indiADX.ADXConfig{Period: 14},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level(25),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := ADX{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestADXConditionsMet(t *testing.T) {
+	info := indiADX.ADXInfo{
+		ADX:     decimal.New(30, 0),
+		PlusDI:  decimal.New(25, 0),
+		MinusDI: decimal.New(15, 0),
+	}
+
+	prevInfo := indiADX.ADXInfo{
+		ADX:     decimal.New(28, 0),
+		PlusDI:  decimal.New(18, 0),
+		MinusDI: decimal.New(20, 0),
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *ADX
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when ADX calc returns error",
+			Tool:        &ADX{adx: adx_mock.NewADXMock(indiADX.ADXInfo{}, errors.New("test"), 1, 0)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when previous ADX calc returns error",
+			Tool: &ADX{
+				adx:     adx_mock.NewADXMock(info, nil, 1, 0),
+				prevADX: adx_mock.NewADXMock(indiADX.ADXInfo{}, errors.New("test"), 1, 1),
+				conf: settings{
+					Direction: directionUp,
+					Cross:     true,
+					Cond:      tools.Cond{C: tools.CondAbove},
+					Level:     level(25),
+				},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call without direction",
+			Tool: &ADX{
+				adx: adx_mock.NewADXMock(info, nil, 1, 0),
+				conf: settings{
+					Cond:  tools.Cond{C: tools.CondAbove},
+					Level: level(25),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{ADXInfo: info},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when direction does not match",
+			Tool: &ADX{
+				adx: adx_mock.NewADXMock(info, nil, 1, 0),
+				conf: settings{
+					Direction: directionDown,
+					Cond:      tools.Cond{C: tools.CondAbove},
+					Level:     level(25),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{ADXInfo: info},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when DI lines have crossed",
+			Tool: &ADX{
+				adx:     adx_mock.NewADXMock(info, nil, 1, 0),
+				prevADX: adx_mock.NewADXMock(prevInfo, nil, 1, 1),
+				conf: settings{
+					Direction: directionUp,
+					Cross:     true,
+					Cond:      tools.Cond{C: tools.CondAbove},
+					Level:     level(25),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{Prev: &prevInfo, ADXInfo: info},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when DI lines have not crossed",
+			Tool: &ADX{
+				adx:     adx_mock.NewADXMock(info, nil, 1, 0),
+				prevADX: adx_mock.NewADXMock(info, nil, 1, 1),
+				conf: settings{
+					Direction: directionUp,
+					Cross:     true,
+					Cond:      tools.Cond{C: tools.CondAbove},
+					Level:     level(25),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{Prev: &info, ADXInfo: info},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(exchange.Data{})
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestADXCandlesCount(t *testing.T) {
+	obj := ADX{
+		adx:     adx_mock.NewADXMock(indiADX.ADXInfo{}, nil, 3, 0),
+		prevADX: adx_mock.NewADXMock(indiADX.ADXInfo{}, nil, 3, 1),
+	}
+	assert.Equal(t, 9, obj.CandlesCount())
+
+	obj.conf.Cross = true
+	assert.Equal(t, 10, obj.CandlesCount())
+}
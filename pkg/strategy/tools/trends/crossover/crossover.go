@@ -0,0 +1,209 @@
+package crossover
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	directionUp   = "up"
+	directionDown = "down"
+
+	maxWithin = 200
+)
+
+// ErrSeriesInvalid is returned when series data object is neither
+// a candle price, nor a moving average or VWAP. Indicator values
+// (e.g. RSI, MACD line) cannot be used as crossover series, because
+// they are not in the price scale and may be negative.
+var ErrSeriesInvalid = errors.New("series object is invalid, only candle prices, moving averages and vwap can be used (indicator values, e.g. rsi or macd, are not supported)")
+
+type Crossover struct {
+	// obj1 and obj2 contain series objects, index of
+	// each element specifies its offset (candles back from the latest).
+	obj1 []tools.CondObject
+	obj2 []tools.CondObject
+
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Obj1 specifies the series that needs to cross Obj2.
+	Obj1 tools.CondObject `json:"obj1"`
+
+	// Obj2 specifies the series that needs to be crossed by Obj1.
+	Obj2 tools.CondObject `json:"obj2"`
+
+	// Direction specifies whether Obj1 needs to cross
+	// Obj2 from below (up) or from above (down).
+	Direction string `json:"direction" conform:"trim,lower"`
+
+	// Within specifies in how many latest candles
+	// the cross needs to happen.
+	Within int `json:"within"`
+}
+
+type snapshot struct {
+	// Obj1Vals and Obj2Vals contain series values starting
+	// from the latest candle.
+	Obj1Vals []decimal.Decimal `json:"obj1Vals"`
+	Obj2Vals []decimal.Decimal `json:"obj2Vals"`
+
+	// CrossIndex specifies how many candles back from the
+	// latest the last cross happened (-1 if there was no cross).
+	CrossIndex int `json:"crossIndex"`
+}
+
+func New(conf func(v interface{}) error) (*Crossover, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	if s.Within == 0 {
+		s.Within = 1
+	}
+
+	s.Obj1.AllowCandlePrice()
+	s.Obj1.AllowMA()
+	s.Obj1.AllowVWAP()
+	s.Obj2.AllowCandlePrice()
+	s.Obj2.AllowMA()
+	s.Obj2.AllowVWAP()
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	// every cross check needs values of the
+	// candle and the one before it.
+	obj1 := make([]tools.CondObject, 0, s.Within+1)
+	obj2 := make([]tools.CondObject, 0, s.Within+1)
+	for i := 0; i <= s.Within; i++ {
+		o1 := s.Obj1
+		if err := o1.Init(i); err != nil {
+			return nil, err
+		}
+
+		o2 := s.Obj2
+		if err := o2.Init(i); err != nil {
+			return nil, err
+		}
+
+		obj1 = append(obj1, o1)
+		obj2 = append(obj2, o2)
+	}
+
+	return &Crossover{
+		obj1: obj1,
+		obj2: obj2,
+		conf: s,
+	}, nil
+}
+
+func (s settings) validate() error {
+	switch s.Direction {
+	case directionUp, directionDown:
+		break
+	default:
+		return errors.New("direction is invalid")
+	}
+
+	if s.Within < 1 || s.Within > maxWithin {
+		return errors.New("within must be between 1 and 200 (inclusively)")
+	}
+
+	if err := s.Obj1.Validate(); err != nil {
+		return seriesError(err)
+	}
+
+	if err := s.Obj2.Validate(); err != nil {
+		return seriesError(err)
+	}
+
+	return nil
+}
+
+// seriesError replaces generic invalid condition
+// object error with ErrSeriesInvalid.
+func seriesError(err error) error {
+	if err == tools.ErrCondObjectInvalid {
+		return ErrSeriesInvalid
+	}
+	return err
+}
+
+func (c *Crossover) Validate() error {
+	return c.conf.validate()
+}
+
+func (c *Crossover) ConditionsMet(d exchange.Data) (bool, error) {
+	vals1 := make([]decimal.Decimal, 0, len(c.obj1))
+	vals2 := make([]decimal.Decimal, 0, len(c.obj2))
+	for i := range c.obj1 {
+		val1, err := c.obj1[i].Value(d)
+		if err != nil {
+			c.snapshot.Clear()
+			return false, err
+		}
+
+		val2, err := c.obj2[i].Value(d)
+		if err != nil {
+			c.snapshot.Clear()
+			return false, err
+		}
+
+		vals1 = append(vals1, val1)
+		vals2 = append(vals2, val2)
+	}
+
+	// only the latest cross is used, so that
+	// a cross followed by a cross in opposite direction
+	// would not be treated as a signal.
+	crossIndex := -1
+	isMet := false
+	for i := 0; i < len(vals1)-1; i++ {
+		up := vals1[i].GreaterThan(vals2[i]) && vals1[i+1].LessThanOrEqual(vals2[i+1])
+		down := vals1[i].LessThan(vals2[i]) && vals1[i+1].GreaterThanOrEqual(vals2[i+1])
+		if !up && !down {
+			continue
+		}
+
+		crossIndex = i
+		isMet = (up && c.conf.Direction == directionUp) || (down && c.conf.Direction == directionDown)
+		break
+	}
+
+	// collect snapshot data
+	c.snapshot.Set(snapshot{
+		Obj1Vals:   vals1,
+		Obj2Vals:   vals2,
+		CrossIndex: crossIndex,
+	}, isMet)
+	return isMet, nil
+}
+
+func (c *Crossover) CandlesCount() int {
+	count := 0
+	for i := range c.obj1 {
+		if c.obj1[i].CandlesCount() > count {
+			count = c.obj1[i].CandlesCount()
+		}
+
+		if c.obj2[i].CandlesCount() > count {
+			count = c.obj2[i].CandlesCount()
+		}
+	}
+
+	return count
+}
+
+func (c *Crossover) Snapshot() tools.Snapshot {
+	return c.snapshot.Get()
+}
+
+func (c *Crossover) Reset() {}
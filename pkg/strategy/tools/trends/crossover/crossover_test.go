@@ -0,0 +1,362 @@
+package crossover
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/ma"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func condObject(obj string) tools.CondObject {
+	val := tools.CondObject{
+		Obj: obj,
+	}
+	val.AllowCandlePrice()
+	val.AllowMA()
+	return val
+}
+
+func crossoverTool(dir string, within int) *Crossover {
+	c, err := New(func(v interface{}) error {
+		val := v.(*settings)
+		val.Obj1 = tools.CondObject{Obj: exchange.ClosePrice}
+		val.Obj2 = tools.CondObject{Obj: exchange.OpenPrice}
+		val.Direction = dir
+		val.Within = within
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+func TestCrossoverNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		Err         error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when direction is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Obj1.Obj = exchange.ClosePrice
+				val.Obj2.Obj = exchange.OpenPrice
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when within is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Obj1.Obj = exchange.ClosePrice
+				val.Obj2.Obj = exchange.OpenPrice
+				val.Direction = directionUp
+				val.Within = 201
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when Obj2 is a ticker price",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Obj1.Obj = exchange.ClosePrice
+				val.Obj2.Obj = exchange.LastPrice
+				val.Direction = directionUp
+				return nil
+			},
+			Err:         ErrSeriesInvalid,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when Obj1 is an indicator value",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Obj1.Obj = "rsi"
+				val.Obj1.ObjConf = []byte(`{"period":14,"price":"close"}`)
+				val.Obj2.Obj = exchange.ClosePrice
+				val.Direction = directionUp
+				return nil
+			},
+			Err:         ErrSeriesInvalid,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Obj1.Obj = exchange.ClosePrice
+				val.Obj2.Obj = ma.EMAName
+				val.Obj2.ObjConf = []byte(`{"period":3,"price":"close"}`)
+				val.Direction = directionDown
+				val.Within = 3
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				if v.Err != nil {
+					assert.Equal(t, v.Err, err)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCrossoverValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when direction is invalid",
+			Settings: settings{
+				Obj1:      condObject(exchange.ClosePrice),
+				Obj2:      condObject(exchange.OpenPrice),
+				Direction: "test",
+				Within:    1,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when within is below 1",
+			Settings: settings{
+				Obj1:      condObject(exchange.ClosePrice),
+				Obj2:      condObject(exchange.OpenPrice),
+				Direction: directionUp,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when within is above 200",
+			Settings: settings{
+				Obj1:      condObject(exchange.ClosePrice),
+				Obj2:      condObject(exchange.OpenPrice),
+				Direction: directionUp,
+				Within:    201,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Obj1 is invalid",
+			Settings: settings{
+				Obj1:      condObject("test"),
+				Obj2:      condObject(exchange.OpenPrice),
+				Direction: directionUp,
+				Within:    1,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Obj2 is invalid",
+			Settings: settings{
+				Obj1:      condObject(exchange.ClosePrice),
+				Obj2:      condObject("test"),
+				Direction: directionUp,
+				Within:    1,
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Obj1:      condObject(exchange.ClosePrice),
+				Obj2:      condObject(exchange.OpenPrice),
+				Direction: directionUp,
+				Within:    200,
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := Crossover{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCrossoverConditionsMet(t *testing.T) {
+	candle := func(open, close int64) exchange.Candle {
+		return exchange.Candle{
+			Open:  decimal.New(open, 0),
+			Close: decimal.New(close, 0),
+		}
+	}
+
+	vals := func(vv ...int64) []decimal.Decimal {
+		res := make([]decimal.Decimal, 0, len(vv))
+		for _, v := range vv {
+			res = append(res, decimal.New(v, 0))
+		}
+		return res
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *Crossover
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when there are not enough candles",
+			Tool:        crossoverTool(directionUp, 1),
+			Data:        exchange.Data{Candles: []exchange.Candle{candle(10, 12)}},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when cross up happened on the latest candle",
+			Tool: crossoverTool(directionUp, 1),
+			Data: exchange.Data{
+				Candles: []exchange.Candle{candle(10, 9), candle(10, 12)},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Obj1Vals:   vals(12, 9),
+					Obj2Vals:   vals(10, 10),
+					CrossIndex: 0,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when cross up happened within latest candles",
+			Tool: crossoverTool(directionUp, 3),
+			Data: exchange.Data{
+				Candles: []exchange.Candle{candle(10, 9), candle(10, 11), candle(10, 12), candle(10, 13)},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Obj1Vals:   vals(13, 12, 11, 9),
+					Obj2Vals:   vals(10, 10, 10, 10),
+					CrossIndex: 2,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when cross up happened outside of latest candles",
+			Tool: crossoverTool(directionUp, 2),
+			Data: exchange.Data{
+				Candles: []exchange.Candle{candle(10, 9), candle(10, 11), candle(10, 12), candle(10, 13)},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					Obj1Vals:   vals(13, 12, 11),
+					Obj2Vals:   vals(10, 10, 10),
+					CrossIndex: -1,
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when cross up was followed by cross down",
+			Tool: crossoverTool(directionUp, 3),
+			Data: exchange.Data{
+				Candles: []exchange.Candle{candle(10, 9), candle(10, 11), candle(10, 10), candle(10, 8)},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					Obj1Vals:   vals(8, 10, 11, 9),
+					Obj2Vals:   vals(10, 10, 10, 10),
+					CrossIndex: 0,
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when cross down happened",
+			Tool: crossoverTool(directionDown, 3),
+			Data: exchange.Data{
+				Candles: []exchange.Candle{candle(10, 9), candle(10, 11), candle(10, 10), candle(10, 8)},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Obj1Vals:   vals(8, 10, 11, 9),
+					Obj2Vals:   vals(10, 10, 10, 10),
+					CrossIndex: 0,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCrossoverCandlesCount(t *testing.T) {
+	assert.Equal(t, 2, crossoverTool(directionUp, 1).CandlesCount())
+	assert.Equal(t, 6, crossoverTool(directionUp, 5).CandlesCount())
+
+	c, err := New(func(v interface{}) error {
+		val := v.(*settings)
+		val.Obj1 = tools.CondObject{Obj: exchange.ClosePrice}
+		val.Obj2 = tools.CondObject{Obj: ma.SMAName, ObjConf: []byte(`{"period":4,"price":"close"}`)}
+		val.Direction = directionUp
+		val.Within = 2
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 6, c.CandlesCount())
+}
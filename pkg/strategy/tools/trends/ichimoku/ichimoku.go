@@ -0,0 +1,190 @@
+package ichimoku
+
+import (
+	"eonbot/pkg/exchange"
+	indiIchimoku "eonbot/pkg/strategy/indicators/ichimoku"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+)
+
+const (
+	positionAbove  = "abovecloud"
+	positionBelow  = "belowcloud"
+	positionInside = "insidecloud"
+
+	crossBullish = "bullish"
+	crossBearish = "bearish"
+)
+
+type Ichimoku struct {
+	ichimoku     indiIchimoku.Ichimoku
+	prevIchimoku indiIchimoku.Ichimoku
+	conf         settings
+	snapshot     tools.SnapshotManager
+}
+
+type settings struct {
+	// Position specifies where the object value must be
+	// compared to the cloud: aboveCloud, belowCloud or
+	// insideCloud. Optional if TKCross is specified.
+	Position string `json:"position" conform:"trim,lower"`
+
+	// TKCross specifies which conversion and base lines
+	// cross must have happened on the latest candle:
+	// bullish or bearish. Optional if Position is specified.
+	TKCross string `json:"tkCross" conform:"trim,lower"`
+
+	indiIchimoku.IchimokuConfig
+	tools.CondObject
+}
+
+type snapshot struct {
+	Prev *indiIchimoku.IchimokuInfo `json:"prev,omitempty"`
+	tools.CondObjectSnapshot
+	indiIchimoku.IchimokuInfo
+}
+
+func New(conf func(v interface{}) error) (*Ichimoku, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	ichimoku, err := indiIchimoku.NewFromConfig(s.IchimokuConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// previous candle's values are used to
+	// detect conversion and base lines cross.
+	prevIchimoku, err := indiIchimoku.NewFromConfig(s.IchimokuConfig, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Position != "" {
+		s.CondObject.AllowTickerPrice()
+		s.CondObject.AllowCandlePrice()
+		if err := s.CondObject.Init(0); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Ichimoku{
+		ichimoku:     ichimoku,
+		prevIchimoku: prevIchimoku,
+		conf:         s,
+	}, nil
+}
+
+func (i *Ichimoku) Validate() error {
+	if i.conf.Position == "" && i.conf.TKCross == "" {
+		return errors.New("position or tk cross must be specified")
+	}
+
+	switch i.conf.Position {
+	case "", positionAbove, positionBelow, positionInside:
+		break
+	default:
+		return errors.New("position is invalid")
+	}
+
+	switch i.conf.TKCross {
+	case "", crossBullish, crossBearish:
+		break
+	default:
+		return errors.New("tk cross type is invalid")
+	}
+
+	if err := i.conf.IchimokuConfig.Validate(); err != nil {
+		return err
+	}
+
+	if i.conf.Position == "" {
+		return nil
+	}
+
+	if err := i.conf.CondObject.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *Ichimoku) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := i.ichimoku.Calc(d.Candles)
+	if err != nil {
+		i.snapshot.Clear()
+		return false, err
+	}
+
+	snap := snapshot{IchimokuInfo: info}
+	isMet := true
+
+	if i.conf.Position != "" {
+		val, err := i.conf.CondObject.Value(d)
+		if err != nil {
+			i.snapshot.Clear()
+			return false, err
+		}
+
+		snap.CondObjectSnapshot = i.conf.CondObject.Snapshot(val)
+
+		switch i.conf.Position {
+		case positionAbove:
+			isMet = val.GreaterThan(info.CloudTop())
+		case positionBelow:
+			isMet = val.LessThan(info.CloudBottom())
+		case positionInside:
+			isMet = val.GreaterThanOrEqual(info.CloudBottom()) && val.LessThanOrEqual(info.CloudTop())
+		default:
+			i.snapshot.Clear()
+			return false, errors.New("position is invalid")
+		}
+	}
+
+	if i.conf.TKCross != "" {
+		prev, err := i.prevIchimoku.Calc(d.Candles)
+		if err != nil {
+			i.snapshot.Clear()
+			return false, err
+		}
+
+		snap.Prev = &prev
+
+		switch i.conf.TKCross {
+		case crossBullish:
+			isMet = isMet && info.ConversionLine.GreaterThan(info.BaseLine) &&
+				prev.ConversionLine.LessThanOrEqual(prev.BaseLine)
+		case crossBearish:
+			isMet = isMet && info.ConversionLine.LessThan(info.BaseLine) &&
+				prev.ConversionLine.GreaterThanOrEqual(prev.BaseLine)
+		default:
+			i.snapshot.Clear()
+			return false, errors.New("tk cross type is invalid")
+		}
+	}
+
+	// collect snapshot data
+	i.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+func (i *Ichimoku) CandlesCount() int {
+	res := i.ichimoku.CandlesCount()
+	if i.conf.TKCross != "" {
+		res = i.prevIchimoku.CandlesCount()
+	}
+
+	if i.conf.CondObject.CandlesCount() > res {
+		res = i.conf.CondObject.CandlesCount()
+	}
+
+	return res
+}
+
+func (i *Ichimoku) Snapshot() tools.Snapshot {
+	return i.snapshot.Get()
+}
+
+func (i *Ichimoku) Reset() {}
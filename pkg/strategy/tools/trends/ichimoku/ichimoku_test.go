@@ -0,0 +1,368 @@
+package ichimoku
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/ichimoku_mock"
+	indiIchimoku "eonbot/pkg/strategy/indicators/ichimoku"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func condObject(obj string) tools.CondObject {
+	val := tools.CondObject{
+		Obj: obj,
+	}
+	val.AllowTickerPrice()
+	val.AllowCandlePrice()
+	val.Init(0)
+	return val
+}
+
+func ichimokuConfig() indiIchimoku.IchimokuConfig {
+	return indiIchimoku.IchimokuConfig{
+		ConversionPeriod: 9,
+		BasePeriod:       26,
+		SpanBPeriod:      52,
+		Displacement:     26,
+	}
+}
+
+func TestIchimokuNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when IchimokuConfig is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when CondObject has invalid object",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Position = positionAbove
+				val.IchimokuConfig = ichimokuConfig()
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation without position",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.TKCross = crossBullish
+				val.IchimokuConfig = ichimokuConfig()
+				return nil
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Position = positionAbove
+				val.IchimokuConfig = ichimokuConfig()
+				val.CondObject.Obj = exchange.ClosePrice
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestIchimokuValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when neither position nor tk cross is specified",
+			Settings: settings{
+				IchimokuConfig: ichimokuConfig(),
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when position is invalid",
+			Settings: settings{
+				Position:       "test",
+				IchimokuConfig: ichimokuConfig(),
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when tk cross type is invalid",
+			Settings: settings{
+				TKCross:        "test",
+				IchimokuConfig: ichimokuConfig(),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when IchimokuConfig is invalid",
+			Settings: settings{
+				Position:       positionBelow,
+				IchimokuConfig: indiIchimoku.IchimokuConfig{},
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when CondObject has invalid object",
+			Settings: settings{
+				Position:       positionBelow,
+				IchimokuConfig: ichimokuConfig(),
+				CondObject:     condObject("test"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation without position",
+			Settings: settings{
+				TKCross:        crossBearish,
+				IchimokuConfig: ichimokuConfig(),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Position:       positionInside,
+				TKCross:        crossBullish,
+				IchimokuConfig: ichimokuConfig(),
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := Ichimoku{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestIchimokuConditionsMet(t *testing.T) {
+	info := indiIchimoku.IchimokuInfo{
+		ConversionLine: decimal.New(12, 0),
+		BaseLine:       decimal.New(11, 0),
+		SpanA:          decimal.New(10, 0),
+		SpanB:          decimal.New(8, 0),
+	}
+
+	prevInfo := indiIchimoku.IchimokuInfo{
+		ConversionLine: decimal.New(10, 0),
+		BaseLine:       decimal.New(11, 0),
+		SpanA:          decimal.New(10, 0),
+		SpanB:          decimal.New(8, 0),
+	}
+
+	data := func(price int64) exchange.Data {
+		return exchange.Data{
+			Ticker: exchange.TickerData{
+				LastPrice: decimal.New(price, 0),
+			},
+		}
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *Ichimoku
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when Ichimoku calc returns error",
+			Tool:        &Ichimoku{ichimoku: ichimoku_mock.NewIchimokuMock(indiIchimoku.IchimokuInfo{}, errors.New("test"), 1, 0)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when CondObject is not initialized",
+			Tool: &Ichimoku{
+				ichimoku: ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				conf:     settings{Position: positionAbove},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when position is invalid",
+			Tool: &Ichimoku{
+				ichimoku: ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				conf: settings{
+					Position:   "test",
+					CondObject: condObject(exchange.LastPrice),
+				},
+			},
+			Data:        data(11),
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when previous Ichimoku calc returns error",
+			Tool: &Ichimoku{
+				ichimoku:     ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				prevIchimoku: ichimoku_mock.NewIchimokuMock(indiIchimoku.IchimokuInfo{}, errors.New("test"), 1, 1),
+				conf:         settings{TKCross: crossBullish},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when price is above cloud",
+			Tool: &Ichimoku{
+				ichimoku: ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				conf: settings{
+					Position:   positionAbove,
+					CondObject: condObject(exchange.LastPrice),
+				},
+			},
+			Data: data(11),
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(11, 0),
+					},
+					IchimokuInfo: info,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when price is not below cloud",
+			Tool: &Ichimoku{
+				ichimoku: ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				conf: settings{
+					Position:   positionBelow,
+					CondObject: condObject(exchange.LastPrice),
+				},
+			},
+			Data: data(9),
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(9, 0),
+					},
+					IchimokuInfo: info,
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when price is inside cloud and bullish cross happened",
+			Tool: &Ichimoku{
+				ichimoku:     ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				prevIchimoku: ichimoku_mock.NewIchimokuMock(prevInfo, nil, 1, 1),
+				conf: settings{
+					Position:   positionInside,
+					TKCross:    crossBullish,
+					CondObject: condObject(exchange.LastPrice),
+				},
+			},
+			Data: data(9),
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Prev: &prevInfo,
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(9, 0),
+					},
+					IchimokuInfo: info,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when bearish cross did not happen",
+			Tool: &Ichimoku{
+				ichimoku:     ichimoku_mock.NewIchimokuMock(info, nil, 1, 0),
+				prevIchimoku: ichimoku_mock.NewIchimokuMock(prevInfo, nil, 1, 1),
+				conf:         settings{TKCross: crossBearish},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					Prev:         &prevInfo,
+					IchimokuInfo: info,
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestIchimokuCandlesCount(t *testing.T) {
+	obj := Ichimoku{
+		ichimoku:     ichimoku_mock.NewIchimokuMock(indiIchimoku.IchimokuInfo{}, nil, 3, 0),
+		prevIchimoku: ichimoku_mock.NewIchimokuMock(indiIchimoku.IchimokuInfo{}, nil, 3, 1),
+		conf:         settings{CondObject: condObject(exchange.ClosePrice)},
+	}
+	assert.Equal(t, 3, obj.CandlesCount())
+
+	obj.conf.TKCross = crossBullish
+	assert.Equal(t, 4, obj.CandlesCount())
+}
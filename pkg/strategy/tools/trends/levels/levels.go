@@ -0,0 +1,321 @@
+package levels
+
+import (
+	"eonbot/pkg/exchange"
+	indiPivot "eonbot/pkg/strategy/indicators/pivot"
+	indiSwing "eonbot/pkg/strategy/indicators/swing"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	methodSwing = "swing"
+
+	levelPivot      = "pivot"
+	levelR1         = "r1"
+	levelR2         = "r2"
+	levelR3         = "r3"
+	levelR4         = "r4"
+	levelS1         = "s1"
+	levelS2         = "s2"
+	levelS3         = "s3"
+	levelS4         = "s4"
+	levelSupport    = "support"
+	levelResistance = "resistance"
+
+	positionNear  = "near"
+	positionAbove = "above"
+	positionBelow = "below"
+
+	maxOffset      = 200
+	maxPivotOffset = 30
+)
+
+type Levels struct {
+	pivot    indiPivot.Pivot
+	swing    indiSwing.Swing
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Method specifies how levels should be calculated:
+	// classic, fibonacci, camarilla (pivot points) or swing.
+	Method string `json:"method" conform:"trim,lower"`
+
+	// Period specifies how many candles should be merged into
+	// a single higher-interval candle when calculating pivot points or
+	// how many candles should be searched for swing highs and lows.
+	Period int `json:"period"`
+
+	// Strength specifies how many candles on each side
+	// of the swing point must have lower highs (or higher lows).
+	// Used only with swing method.
+	Strength int `json:"strength"`
+
+	// Offset specifies how many latest candles should be
+	// skipped when calculating swing levels.
+	Offset int `json:"offset"`
+
+	// PivotOffset specifies how many latest completed
+	// sessions (higher-interval candles) should be skipped
+	// when calculating pivot points.
+	PivotOffset int `json:"pivotOffset"`
+
+	// Level specifies which level should be used.
+	Level string `json:"level" conform:"trim,lower"`
+
+	// Position specifies where the data object value
+	// should be compared with the level: near, above or below.
+	Position string `json:"position" conform:"trim,lower"`
+
+	// Tolerance specifies how far from the level (in calc
+	// type's units) the value can be to be considered near it
+	// or how far it must be to be considered above/below it.
+	Tolerance decimal.Decimal `json:"tolerance"`
+
+	tools.CondObject
+	tools.Diff
+}
+
+type snapshot struct {
+	Pivot *indiPivot.PivotInfo `json:"pivot,omitempty"`
+	Swing *indiSwing.SwingInfo `json:"swing,omitempty"`
+
+	LevelVal decimal.Decimal `json:"levelVal"`
+	DiffVal  decimal.Decimal `json:"diffVal"`
+	tools.CondObjectSnapshot
+}
+
+func New(conf func(v interface{}) error) (*Levels, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	var l Levels
+	if s.Method == methodSwing {
+		swing, err := indiSwing.NewFromConfig(s.swingConfig(), s.Offset)
+		if err != nil {
+			return nil, err
+		}
+		l.swing = swing
+	} else {
+		pivot, err := indiPivot.NewFromConfig(s.pivotConfig(), s.PivotOffset)
+		if err != nil {
+			return nil, err
+		}
+		l.pivot = pivot
+	}
+
+	s.CondObject.AllowTickerPrice()
+	s.CondObject.AllowCandlePrice()
+	if err := s.CondObject.Init(0); err != nil {
+		return nil, err
+	}
+
+	s.Diff.AllowATR()
+	if err := s.Diff.Init(); err != nil {
+		return nil, err
+	}
+
+	l.conf = s
+	return &l, nil
+}
+
+func (s settings) pivotConfig() indiPivot.PivotConfig {
+	return indiPivot.PivotConfig{
+		Method: s.Method,
+		Period: s.Period,
+	}
+}
+
+func (s settings) swingConfig() indiSwing.SwingConfig {
+	return indiSwing.SwingConfig{
+		Period:   s.Period,
+		Strength: s.Strength,
+	}
+}
+
+func (l *Levels) Validate() error {
+	if l.conf.Method == methodSwing {
+		conf := l.conf.swingConfig()
+		if err := conf.Validate(); err != nil {
+			return err
+		}
+
+		switch l.conf.Level {
+		case levelSupport, levelResistance:
+			break
+		default:
+			return errors.New("level is invalid")
+		}
+	} else {
+		conf := l.conf.pivotConfig()
+		if err := conf.Validate(); err != nil {
+			return err
+		}
+
+		switch l.conf.Level {
+		case levelPivot, levelR1, levelR2, levelR3, levelS1, levelS2, levelS3:
+			break
+		case levelR4, levelS4:
+			if l.conf.Method != indiPivot.CamarillaName {
+				return errors.New("r4 and s4 levels can only be used with camarilla method")
+			}
+		default:
+			return errors.New("level is invalid")
+		}
+	}
+
+	if l.conf.Offset < 0 || l.conf.Offset > maxOffset {
+		return errors.New("offset must be between 0 and 200 (inclusively)")
+	}
+
+	if l.conf.PivotOffset < 0 || l.conf.PivotOffset > maxPivotOffset {
+		return errors.New("pivot offset must be between 0 and 30 (inclusively)")
+	}
+
+	switch l.conf.Position {
+	case positionNear, positionAbove, positionBelow:
+		break
+	default:
+		return errors.New("position is invalid")
+	}
+
+	if l.conf.Tolerance.IsNegative() {
+		return errors.New("tolerance cannot be negative")
+	}
+
+	if err := l.conf.CondObject.Validate(); err != nil {
+		return err
+	}
+
+	if err := l.conf.Diff.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (l *Levels) ConditionsMet(d exchange.Data) (bool, error) {
+	var snap snapshot
+	if l.swing != nil {
+		info, err := l.swing.Calc(d.Candles)
+		if err != nil {
+			l.snapshot.Clear()
+			return false, err
+		}
+
+		snap.Swing = &info
+		snap.LevelVal = swingLevel(info, l.conf.Level)
+	} else {
+		info, err := l.pivot.Calc(d.Candles)
+		if err != nil {
+			l.snapshot.Clear()
+			return false, err
+		}
+
+		snap.Pivot = &info
+		snap.LevelVal = pivotLevel(info, l.conf.Level)
+	}
+
+	val, err := l.conf.CondObject.Value(d)
+	if err != nil {
+		l.snapshot.Clear()
+		return false, err
+	}
+	snap.CondObjectSnapshot = l.conf.CondObject.Snapshot(val)
+
+	// swing levels might not be found.
+	if snap.LevelVal.IsZero() {
+		// collect snapshot data
+		l.snapshot.Set(snap, false)
+		return false, nil
+	}
+
+	if err := l.conf.Diff.Update(d.Candles); err != nil {
+		l.snapshot.Clear()
+		return false, err
+	}
+
+	snap.DiffVal = l.conf.Diff.Diff(snap.LevelVal, val)
+
+	isMet := false
+	switch l.conf.Position {
+	case positionNear:
+		isMet = snap.DiffVal.Abs().LessThanOrEqual(l.conf.Tolerance)
+	case positionAbove:
+		isMet = snap.DiffVal.GreaterThan(l.conf.Tolerance)
+	case positionBelow:
+		isMet = snap.DiffVal.LessThan(l.conf.Tolerance.Neg())
+	default:
+		l.snapshot.Clear()
+		return false, errors.New("position is invalid")
+	}
+
+	// collect snapshot data
+	l.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+func (l *Levels) CandlesCount() int {
+	count := l.conf.CondObject.CandlesCount()
+	if l.conf.Diff.CandlesCount() > count {
+		count = l.conf.Diff.CandlesCount()
+	}
+
+	if l.swing != nil && l.swing.CandlesCount() > count {
+		count = l.swing.CandlesCount()
+	}
+
+	if l.pivot != nil && l.pivot.CandlesCount() > count {
+		count = l.pivot.CandlesCount()
+	}
+
+	return count
+}
+
+func (l *Levels) Snapshot() tools.Snapshot {
+	return l.snapshot.Get()
+}
+
+func (l *Levels) Reset() {}
+
+func pivotLevel(info indiPivot.PivotInfo, level string) decimal.Decimal {
+	switch level {
+	case levelPivot:
+		return info.Pivot
+	case levelR1:
+		return info.R1
+	case levelR2:
+		return info.R2
+	case levelR3:
+		return info.R3
+	case levelR4:
+		return info.R4
+	case levelS1:
+		return info.S1
+	case levelS2:
+		return info.S2
+	case levelS3:
+		return info.S3
+	case levelS4:
+		return info.S4
+	default:
+		return decimal.Zero
+	}
+}
+
+func swingLevel(info indiSwing.SwingInfo, level string) decimal.Decimal {
+	switch level {
+	case levelSupport:
+		return info.Support
+	case levelResistance:
+		return info.Resistance
+	default:
+		return decimal.Zero
+	}
+}
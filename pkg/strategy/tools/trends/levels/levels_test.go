@@ -0,0 +1,523 @@
+package levels
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/pivot_mock"
+	"eonbot/pkg/strategy/indicators/all_mocks/swing_mock"
+	indiPivot "eonbot/pkg/strategy/indicators/pivot"
+	indiSwing "eonbot/pkg/strategy/indicators/swing"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func condObject(obj string) tools.CondObject {
+	val := tools.CondObject{
+		Obj: obj,
+	}
+	val.AllowTickerPrice()
+	val.AllowCandlePrice()
+	val.Init(0)
+	return val
+}
+
+func diff(calc string) tools.Diff {
+	return tools.Diff{Calc: tools.Calc{Type: calc}}
+}
+
+func TestLevelsNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when pivot method is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Method = "test"
+				val.Period = 24
+				val.CondObject.Obj = exchange.LastPrice
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when swing config is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Method = methodSwing
+				val.Period = 24
+				val.CondObject.Obj = exchange.LastPrice
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when CondObject has invalid object",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Method = indiPivot.ClassicName
+				val.Period = 24
+				val.CondObject.Obj = "test"
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when Diff has invalid ATR period",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Method = indiPivot.ClassicName
+				val.Period = 24
+				val.CondObject.Obj = exchange.LastPrice
+				val.Diff = diff(tools.CalcATR)
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation with pivot points",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Method = indiPivot.CamarillaName
+				val.Period = 24
+				val.CondObject.Obj = exchange.LastPrice
+				return nil
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful creation with swing levels",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.Method = methodSwing
+				val.Period = 24
+				val.Strength = 2
+				val.CondObject.Obj = exchange.ClosePrice
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestLevelsValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when pivot config is invalid",
+			Settings: settings{
+				Method:     "test",
+				Period:     24,
+				Level:      levelR1,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when swing config is invalid",
+			Settings: settings{
+				Method:     methodSwing,
+				Period:     24,
+				Level:      levelSupport,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when level is invalid for swing method",
+			Settings: settings{
+				Method:     methodSwing,
+				Period:     24,
+				Strength:   2,
+				Level:      levelR1,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when level is invalid for pivot method",
+			Settings: settings{
+				Method:     indiPivot.ClassicName,
+				Period:     24,
+				Level:      levelSupport,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when r4 level is used without camarilla method",
+			Settings: settings{
+				Method:     indiPivot.FibonacciName,
+				Period:     24,
+				Level:      levelR4,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when offset is invalid",
+			Settings: settings{
+				Method:     indiPivot.ClassicName,
+				Period:     24,
+				Offset:     -1,
+				Level:      levelR1,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when pivot offset is invalid",
+			Settings: settings{
+				Method:      indiPivot.ClassicName,
+				Period:      24,
+				PivotOffset: 31,
+				Level:       levelR1,
+				Position:    positionNear,
+				CondObject:  condObject(exchange.LastPrice),
+				Diff:        diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when position is invalid",
+			Settings: settings{
+				Method:     indiPivot.ClassicName,
+				Period:     24,
+				Level:      levelR1,
+				Position:   "test",
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when tolerance is negative",
+			Settings: settings{
+				Method:     indiPivot.ClassicName,
+				Period:     24,
+				Level:      levelR1,
+				Position:   positionNear,
+				Tolerance:  decimal.New(-1, 0),
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when CondObject has invalid object",
+			Settings: settings{
+				Method:     indiPivot.ClassicName,
+				Period:     24,
+				Level:      levelR1,
+				Position:   positionNear,
+				CondObject: condObject("test"),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Diff is invalid",
+			Settings: settings{
+				Method:     indiPivot.ClassicName,
+				Period:     24,
+				Level:      levelR1,
+				Position:   positionNear,
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff("test"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation with pivot points",
+			Settings: settings{
+				Method:     indiPivot.CamarillaName,
+				Period:     24,
+				Level:      levelS4,
+				Position:   positionBelow,
+				Tolerance:  decimal.New(1, 0),
+				CondObject: condObject(exchange.LastPrice),
+				Diff:       diff(tools.CalcPercent),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation with swing levels",
+			Settings: settings{
+				Method:     methodSwing,
+				Period:     24,
+				Strength:   2,
+				Level:      levelResistance,
+				Position:   positionAbove,
+				CondObject: condObject(exchange.ClosePrice),
+				Diff:       diff(tools.CalcUnits),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := Levels{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestLevelsConditionsMet(t *testing.T) {
+	pivotInfo := indiPivot.PivotInfo{
+		Pivot: decimal.New(100, 0),
+		R1:    decimal.New(110, 0),
+		S1:    decimal.New(90, 0),
+	}
+
+	swingInfo := indiSwing.SwingInfo{
+		Resistance: decimal.New(120, 0),
+	}
+
+	data := func(price int64) exchange.Data {
+		return exchange.Data{
+			Ticker: exchange.TickerData{
+				LastPrice: decimal.New(price, 0),
+			},
+		}
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *Levels
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when pivot calc returns error",
+			Tool:        &Levels{pivot: pivot_mock.NewPivotMock(indiPivot.PivotInfo{}, errors.New("test"), 1, 0)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name:        "Unsuccessful func call when swing calc returns error",
+			Tool:        &Levels{swing: swing_mock.NewSwingMock(indiSwing.SwingInfo{}, errors.New("test"), 1, 0)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when CondObject is not initialized",
+			Tool: &Levels{
+				pivot: pivot_mock.NewPivotMock(pivotInfo, nil, 1, 0),
+				conf:  settings{Level: levelR1},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when position is invalid",
+			Tool: &Levels{
+				pivot: pivot_mock.NewPivotMock(pivotInfo, nil, 1, 0),
+				conf: settings{
+					Level:      levelR1,
+					Position:   "test",
+					CondObject: condObject(exchange.LastPrice),
+					Diff:       diff(tools.CalcUnits),
+				},
+			},
+			Data:        data(110),
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when price is near pivot level",
+			Tool: &Levels{
+				pivot: pivot_mock.NewPivotMock(pivotInfo, nil, 1, 0),
+				conf: settings{
+					Level:      levelR1,
+					Position:   positionNear,
+					Tolerance:  decimal.New(1, 0),
+					CondObject: condObject(exchange.LastPrice),
+					Diff:       diff(tools.CalcPercent),
+				},
+			},
+			Data: data(111),
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Pivot:    &pivotInfo,
+					LevelVal: decimal.New(110, 0),
+					DiffVal:  decimal.RequireFromString("0.9090909090909100"),
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(111, 0),
+					},
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when price is not below pivot level",
+			Tool: &Levels{
+				pivot: pivot_mock.NewPivotMock(pivotInfo, nil, 1, 0),
+				conf: settings{
+					Level:      levelS1,
+					Position:   positionBelow,
+					Tolerance:  decimal.New(2, 0),
+					CondObject: condObject(exchange.LastPrice),
+					Diff:       diff(tools.CalcUnits),
+				},
+			},
+			Data: data(89),
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					Pivot:    &pivotInfo,
+					LevelVal: decimal.New(90, 0),
+					DiffVal:  decimal.New(-1, 0),
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(89, 0),
+					},
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when price is above swing resistance",
+			Tool: &Levels{
+				swing: swing_mock.NewSwingMock(swingInfo, nil, 1, 0),
+				conf: settings{
+					Level:      levelResistance,
+					Position:   positionAbove,
+					CondObject: condObject(exchange.LastPrice),
+					Diff:       diff(tools.CalcUnits),
+				},
+			},
+			Data: data(125),
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Swing:    &swingInfo,
+					LevelVal: decimal.New(120, 0),
+					DiffVal:  decimal.New(5, 0),
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(125, 0),
+					},
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when swing support is not found",
+			Tool: &Levels{
+				swing: swing_mock.NewSwingMock(swingInfo, nil, 1, 0),
+				conf: settings{
+					Level:      levelSupport,
+					Position:   positionNear,
+					CondObject: condObject(exchange.LastPrice),
+					Diff:       diff(tools.CalcUnits),
+				},
+			},
+			Data: data(125),
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					Swing: &swingInfo,
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(125, 0),
+					},
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestLevelsCandlesCount(t *testing.T) {
+	obj := Levels{
+		pivot: pivot_mock.NewPivotMock(indiPivot.PivotInfo{}, nil, 24, 1),
+		conf:  settings{CondObject: condObject(exchange.ClosePrice)},
+	}
+	assert.Equal(t, 25, obj.CandlesCount())
+
+	obj = Levels{
+		swing: swing_mock.NewSwingMock(indiSwing.SwingInfo{}, nil, 3, 0),
+		conf: settings{
+			CondObject: condObject(exchange.ClosePrice),
+			Diff: func() tools.Diff {
+				val := tools.Diff{
+					Calc: tools.Calc{
+						Type:      tools.CalcATR,
+						ATRPeriod: 5,
+					},
+				}
+				val.AllowATR()
+				val.Init()
+				return val
+			}(),
+		},
+	}
+	assert.Equal(t, 10, obj.CandlesCount())
+}
@@ -0,0 +1,117 @@
+package psar
+
+import (
+	"eonbot/pkg/exchange"
+	indiPSAR "eonbot/pkg/strategy/indicators/psar"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+)
+
+const (
+	trendUp   = "up"
+	trendDown = "down"
+)
+
+type PSAR struct {
+	psar     indiPSAR.PSAR
+	prevPSAR indiPSAR.PSAR
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	// Trend specifies which trend must be
+	// indicated by SAR: up or down.
+	Trend string `json:"trend" conform:"trim,lower"`
+
+	// Reversal specifies whether trend must have
+	// reversed on the latest candle.
+	Reversal bool `json:"reversal"`
+
+	indiPSAR.PSARConfig
+}
+
+type snapshot struct {
+	Prev *indiPSAR.PSARInfo `json:"prev,omitempty"`
+	indiPSAR.PSARInfo
+}
+
+func New(conf func(v interface{}) error) (*PSAR, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	psar, err := indiPSAR.NewFromConfig(s.PSARConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// previous candle's values are used to
+	// detect trend reversal.
+	prevPSAR, err := indiPSAR.NewFromConfig(s.PSARConfig, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PSAR{
+		psar:     psar,
+		prevPSAR: prevPSAR,
+		conf:     s,
+	}, nil
+}
+
+func (p *PSAR) Validate() error {
+	switch p.conf.Trend {
+	case trendUp, trendDown:
+		break
+	default:
+		return errors.New("trend type is invalid")
+	}
+
+	if err := p.conf.PSARConfig.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *PSAR) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := p.psar.Calc(d.Candles)
+	if err != nil {
+		p.snapshot.Clear()
+		return false, err
+	}
+
+	snap := snapshot{PSARInfo: info}
+	isMet := info.Uptrend == (p.conf.Trend == trendUp)
+
+	if p.conf.Reversal {
+		prev, err := p.prevPSAR.Calc(d.Candles)
+		if err != nil {
+			p.snapshot.Clear()
+			return false, err
+		}
+
+		snap.Prev = &prev
+		isMet = isMet && prev.Uptrend != info.Uptrend
+	}
+
+	// collect snapshot data
+	p.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+func (p *PSAR) CandlesCount() int {
+	if p.conf.Reversal {
+		return p.prevPSAR.CandlesCount()
+	}
+
+	return p.psar.CandlesCount()
+}
+
+func (p *PSAR) Snapshot() tools.Snapshot {
+	return p.snapshot.Get()
+}
+
+func (p *PSAR) Reset() {}
@@ -0,0 +1,224 @@
+package psar
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/psar_mock"
+	indiPSAR "eonbot/pkg/strategy/indicators/psar"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func psarConfig() indiPSAR.PSARConfig {
+	return indiPSAR.PSARConfig{
+		Period:  50,
+		Step:    decimal.RequireFromString("0.02"),
+		MaxStep: decimal.RequireFromString("0.2"),
+	}
+}
+
+func TestPSARNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when PSARConfig is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.PSARConfig = psarConfig()
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestPSARValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when trend type is invalid",
+			Settings: settings{
+				Trend:      "test",
+				PSARConfig: psarConfig(),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when PSARConfig is invalid",
+			Settings: settings{
+				Trend:      trendUp,
+				PSARConfig: indiPSAR.PSARConfig{Period: 50},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Trend:      trendDown,
+				Reversal:   true,
+				PSARConfig: psarConfig(),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := PSAR{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestPSARConditionsMet(t *testing.T) {
+	up := indiPSAR.PSARInfo{SAR: decimal.New(10, 0), Uptrend: true}
+	down := indiPSAR.PSARInfo{SAR: decimal.New(15, 0), Uptrend: false}
+
+	tests := []struct {
+		Name        string
+		Tool        *PSAR
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when PSAR calc returns error",
+			Tool:        &PSAR{psar: psar_mock.NewPSARMock(indiPSAR.PSARInfo{}, errors.New("test"), 1, 0)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when previous PSAR calc returns error",
+			Tool: &PSAR{
+				psar:     psar_mock.NewPSARMock(up, nil, 1, 0),
+				prevPSAR: psar_mock.NewPSARMock(indiPSAR.PSARInfo{}, errors.New("test"), 1, 1),
+				conf:     settings{Trend: trendUp, Reversal: true},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when trend matches",
+			Tool: &PSAR{
+				psar: psar_mock.NewPSARMock(up, nil, 1, 0),
+				conf: settings{Trend: trendUp},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{PSARInfo: up},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when trend does not match",
+			Tool: &PSAR{
+				psar: psar_mock.NewPSARMock(up, nil, 1, 0),
+				conf: settings{Trend: trendDown},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{PSARInfo: up},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when trend has reversed",
+			Tool: &PSAR{
+				psar:     psar_mock.NewPSARMock(down, nil, 1, 0),
+				prevPSAR: psar_mock.NewPSARMock(up, nil, 1, 1),
+				conf:     settings{Trend: trendDown, Reversal: true},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{Prev: &up, PSARInfo: down},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when trend has not reversed",
+			Tool: &PSAR{
+				psar:     psar_mock.NewPSARMock(down, nil, 1, 0),
+				prevPSAR: psar_mock.NewPSARMock(down, nil, 1, 1),
+				conf:     settings{Trend: trendDown, Reversal: true},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{Prev: &down, PSARInfo: down},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(exchange.Data{})
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestPSARCandlesCount(t *testing.T) {
+	obj := PSAR{
+		psar:     psar_mock.NewPSARMock(indiPSAR.PSARInfo{}, nil, 3, 0),
+		prevPSAR: psar_mock.NewPSARMock(indiPSAR.PSARInfo{}, nil, 3, 1),
+	}
+	assert.Equal(t, 3, obj.CandlesCount())
+
+	obj.conf.Reversal = true
+	assert.Equal(t, 4, obj.CandlesCount())
+}
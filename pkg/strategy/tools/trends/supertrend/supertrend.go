@@ -0,0 +1,117 @@
+package supertrend
+
+import (
+	"eonbot/pkg/exchange"
+	indiSuperTrend "eonbot/pkg/strategy/indicators/supertrend"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+)
+
+const (
+	trendUp   = "up"
+	trendDown = "down"
+)
+
+type SuperTrend struct {
+	superTrend     indiSuperTrend.SuperTrend
+	prevSuperTrend indiSuperTrend.SuperTrend
+	conf           settings
+	snapshot       tools.SnapshotManager
+}
+
+type settings struct {
+	// Trend specifies which trend must be
+	// indicated by SuperTrend: up or down.
+	Trend string `json:"trend" conform:"trim,lower"`
+
+	// Reversal specifies whether trend must have
+	// reversed on the latest candle.
+	Reversal bool `json:"reversal"`
+
+	indiSuperTrend.SuperTrendConfig
+}
+
+type snapshot struct {
+	Prev *indiSuperTrend.SuperTrendInfo `json:"prev,omitempty"`
+	indiSuperTrend.SuperTrendInfo
+}
+
+func New(conf func(v interface{}) error) (*SuperTrend, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	superTrend, err := indiSuperTrend.NewFromConfig(s.SuperTrendConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// previous candle's values are used to
+	// detect trend reversal.
+	prevSuperTrend, err := indiSuperTrend.NewFromConfig(s.SuperTrendConfig, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SuperTrend{
+		superTrend:     superTrend,
+		prevSuperTrend: prevSuperTrend,
+		conf:           s,
+	}, nil
+}
+
+func (s *SuperTrend) Validate() error {
+	switch s.conf.Trend {
+	case trendUp, trendDown:
+		break
+	default:
+		return errors.New("trend type is invalid")
+	}
+
+	if err := s.conf.SuperTrendConfig.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SuperTrend) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := s.superTrend.Calc(d.Candles)
+	if err != nil {
+		s.snapshot.Clear()
+		return false, err
+	}
+
+	snap := snapshot{SuperTrendInfo: info}
+	isMet := info.Uptrend == (s.conf.Trend == trendUp)
+
+	if s.conf.Reversal {
+		prev, err := s.prevSuperTrend.Calc(d.Candles)
+		if err != nil {
+			s.snapshot.Clear()
+			return false, err
+		}
+
+		snap.Prev = &prev
+		isMet = isMet && prev.Uptrend != info.Uptrend
+	}
+
+	// collect snapshot data
+	s.snapshot.Set(snap, isMet)
+	return isMet, nil
+}
+
+func (s *SuperTrend) CandlesCount() int {
+	if s.conf.Reversal {
+		return s.prevSuperTrend.CandlesCount()
+	}
+
+	return s.superTrend.CandlesCount()
+}
+
+func (s *SuperTrend) Snapshot() tools.Snapshot {
+	return s.snapshot.Get()
+}
+
+func (s *SuperTrend) Reset() {}
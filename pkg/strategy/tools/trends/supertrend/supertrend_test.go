@@ -0,0 +1,203 @@
+package supertrend
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/supertrend_mock"
+	indiSuperTrend "eonbot/pkg/strategy/indicators/supertrend"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuperTrendNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when SuperTrendConfig is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.SuperTrendConfig = indiSuperTrend.SuperTrendConfig{Period: 10, Multiplier: decimal.New(3, 0)}
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestSuperTrendValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when trend type is invalid",
+			Settings: settings{
+				Trend:            "test",
+				SuperTrendConfig: indiSuperTrend.SuperTrendConfig{Period: 10, Multiplier: decimal.New(3, 0)},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when SuperTrendConfig is invalid",
+			Settings: settings{
+				Trend:            trendUp,
+				SuperTrendConfig: indiSuperTrend.SuperTrendConfig{Period: 10},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Trend:            trendUp,
+				Reversal:         true,
+				SuperTrendConfig: indiSuperTrend.SuperTrendConfig{Period: 10, Multiplier: decimal.New(3, 0)},
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := SuperTrend{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestSuperTrendConditionsMet(t *testing.T) {
+	up := indiSuperTrend.SuperTrendInfo{Value: decimal.New(10, 0), Uptrend: true}
+	down := indiSuperTrend.SuperTrendInfo{Value: decimal.New(15, 0), Uptrend: false}
+
+	tests := []struct {
+		Name        string
+		Tool        *SuperTrend
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when SuperTrend calc returns error",
+			Tool:        &SuperTrend{superTrend: supertrend_mock.NewSuperTrendMock(indiSuperTrend.SuperTrendInfo{}, errors.New("test"), 1, 0)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when previous SuperTrend calc returns error",
+			Tool: &SuperTrend{
+				superTrend:     supertrend_mock.NewSuperTrendMock(up, nil, 1, 0),
+				prevSuperTrend: supertrend_mock.NewSuperTrendMock(indiSuperTrend.SuperTrendInfo{}, errors.New("test"), 1, 1),
+				conf:           settings{Trend: trendUp, Reversal: true},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call when trend matches",
+			Tool: &SuperTrend{
+				superTrend: supertrend_mock.NewSuperTrendMock(down, nil, 1, 0),
+				conf:       settings{Trend: trendDown},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{SuperTrendInfo: down},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when trend has reversed",
+			Tool: &SuperTrend{
+				superTrend:     supertrend_mock.NewSuperTrendMock(up, nil, 1, 0),
+				prevSuperTrend: supertrend_mock.NewSuperTrendMock(down, nil, 1, 1),
+				conf:           settings{Trend: trendUp, Reversal: true},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data:     snapshot{Prev: &down, SuperTrendInfo: up},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call when trend has not reversed",
+			Tool: &SuperTrend{
+				superTrend:     supertrend_mock.NewSuperTrendMock(up, nil, 1, 0),
+				prevSuperTrend: supertrend_mock.NewSuperTrendMock(up, nil, 1, 1),
+				conf:           settings{Trend: trendUp, Reversal: true},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data:     snapshot{Prev: &up, SuperTrendInfo: up},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(exchange.Data{})
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestSuperTrendCandlesCount(t *testing.T) {
+	obj := SuperTrend{
+		superTrend:     supertrend_mock.NewSuperTrendMock(indiSuperTrend.SuperTrendInfo{}, nil, 3, 0),
+		prevSuperTrend: supertrend_mock.NewSuperTrendMock(indiSuperTrend.SuperTrendInfo{}, nil, 3, 1),
+	}
+	assert.Equal(t, 9, obj.CandlesCount())
+
+	obj.conf.Reversal = true
+	assert.Equal(t, 10, obj.CandlesCount())
+}
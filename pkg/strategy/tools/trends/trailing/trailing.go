@@ -42,6 +42,7 @@ func New(conf func(v interface{}) error) (*TrailingTrends, error) {
 
 	s.CondObject.AllowCandlePrice()
 	s.CondObject.AllowMA()
+	s.CondObject.AllowVWAP()
 
 	leadObj := s.CondObject
 	backObj := s.CondObject
@@ -54,6 +55,11 @@ func New(conf func(v interface{}) error) (*TrailingTrends, error) {
 		return nil, err
 	}
 
+	s.Diff.AllowATR()
+	if err := s.Diff.Init(); err != nil {
+		return nil, err
+	}
+
 	return &TrailingTrends{
 		leadObj: leadObj,
 		backObj: backObj,
@@ -94,6 +100,11 @@ func (t *TrailingTrends) ConditionsMet(d exchange.Data) (bool, error) {
 		return false, err
 	}
 
+	if err := t.conf.Diff.Update(d.Candles); err != nil {
+		t.snapshot.Clear()
+		return false, err
+	}
+
 	diff := t.conf.Diff.Diff(val2, val1)
 	isMet := t.conf.Cond.Match(diff, t.conf.Differ)
 
@@ -106,6 +117,9 @@ func (t *TrailingTrends) ConditionsMet(d exchange.Data) (bool, error) {
 }
 
 func (t *TrailingTrends) CandlesCount() int {
+	if t.conf.Diff.CandlesCount() > t.backObj.CandlesCount() {
+		return t.conf.Diff.CandlesCount()
+	}
 	return t.backObj.CandlesCount() // both objects are the same in length, but obj2 is X candles back
 }
 
@@ -358,6 +358,33 @@ func TestTrailingTrendsCandlesCount(t *testing.T) {
 			},
 			Result: 5,
 		},
+		{
+			Name: "Successful candles count return when diff is calculated with ATR",
+			Tool: TrailingTrends{
+				backObj: func() tools.CondObject {
+					val := tools.CondObject{
+						Obj: exchange.ClosePrice,
+					}
+					val.AllowCandlePrice()
+					val.Init(4)
+					return val
+				}(),
+				conf: settings{
+					Diff: func() tools.Diff {
+						val := tools.Diff{
+							Calc: tools.Calc{
+								Type:      tools.CalcATR,
+								ATRPeriod: 3,
+							},
+						}
+						val.AllowATR()
+						val.Init()
+						return val
+					}(),
+				},
+			},
+			Result: 6,
+		},
 	}
 
 	for _, v := range tests {
@@ -0,0 +1,143 @@
+package donchian
+
+import (
+	"eonbot/pkg/exchange"
+	indiDonchian "eonbot/pkg/strategy/indicators/donchian"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	bandLower = "lower"
+	bandUpper = "upper"
+)
+
+type Donchian struct {
+	donchian indiDonchian.Donchian
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	Band string `json:"band" conform:"trim,lower"`
+
+	indiDonchian.DonchianConfig
+	tools.Cond
+	tools.CondObject
+	tools.Shift
+}
+
+type snapshot struct {
+	ShiftedBand decimal.Decimal `json:"shiftedBand"`
+	tools.CondObjectSnapshot
+	indiDonchian.DonchianInfo
+}
+
+func New(conf func(v interface{}) error) (*Donchian, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	// the latest candle is not finished yet, so only the
+	// ones before it are used to form the channels, otherwise
+	// breakouts could not be detected.
+	donchian, err := indiDonchian.NewFromConfig(s.DonchianConfig, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	s.CondObject.AllowTickerPrice()
+	if err := s.CondObject.Init(0); err != nil {
+		return nil, err
+	}
+
+	return &Donchian{
+		donchian: donchian,
+		conf:     s,
+	}, nil
+}
+
+func (dc *Donchian) Validate() error {
+	switch dc.conf.Band {
+	case bandLower, bandUpper:
+		break
+	default:
+		return errors.New("band type is invalid")
+	}
+
+	if err := dc.conf.DonchianConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := dc.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := dc.conf.CondObject.Validate(); err != nil {
+		return err
+	}
+
+	// shift is optional.
+	if dc.conf.Shift.ShiftVal.IsZero() {
+		return nil
+	}
+
+	if err := dc.conf.Shift.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dc *Donchian) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := dc.donchian.Calc(d.Candles)
+	if err != nil {
+		dc.snapshot.Clear()
+		return false, err
+	}
+
+	var band decimal.Decimal
+	switch dc.conf.Band {
+	case bandUpper:
+		band = info.Upper
+	case bandLower:
+		band = info.Lower
+	default:
+		dc.snapshot.Clear()
+		return false, errors.New("band type is invalid")
+	}
+
+	val, err := dc.conf.CondObject.Value(d)
+	if err != nil {
+		dc.snapshot.Clear()
+		return false, err
+	}
+
+	shiftedBand := band
+	if !dc.conf.Shift.ShiftVal.IsZero() {
+		shiftedBand = dc.conf.Shift.CalcVal(band)
+	}
+
+	isMet := dc.conf.Cond.Match(val, shiftedBand)
+
+	dc.snapshot.Set(snapshot{
+		ShiftedBand:        shiftedBand,
+		CondObjectSnapshot: dc.conf.CondObject.Snapshot(val),
+		DonchianInfo:       info,
+	}, isMet)
+
+	return isMet, nil
+}
+
+func (dc *Donchian) CandlesCount() int {
+	return dc.donchian.CandlesCount()
+}
+
+func (dc *Donchian) Snapshot() tools.Snapshot {
+	return dc.snapshot.Get()
+}
+
+func (dc *Donchian) Reset() {}
@@ -0,0 +1,300 @@
+package donchian
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/donchian_mock"
+	indiDonchian "eonbot/pkg/strategy/indicators/donchian"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func condObject(obj string) tools.CondObject {
+	val := tools.CondObject{
+		Obj: obj,
+	}
+	val.AllowTickerPrice()
+	val.Init(0)
+	return val
+}
+
+func TestDonchianNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when DonchianConfig is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when CondObject has invalid object",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.DonchianConfig = indiDonchian.DonchianConfig{Period: 20}
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.DonchianConfig = indiDonchian.DonchianConfig{Period: 20}
+				val.CondObject.Obj = exchange.LastPrice
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestDonchianValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when band type is invalid",
+			Settings: settings{
+				Band:           "test",
+				DonchianConfig: indiDonchian.DonchianConfig{Period: 20},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when DonchianConfig is invalid",
+			Settings: settings{
+				Band:           bandUpper,
+				DonchianConfig: indiDonchian.DonchianConfig{},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				Band:           bandUpper,
+				DonchianConfig: indiDonchian.DonchianConfig{Period: 20},
+				Cond:           tools.Cond{},
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when CondObject has invalid object",
+			Settings: settings{
+				Band:           bandUpper,
+				DonchianConfig: indiDonchian.DonchianConfig{Period: 20},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				CondObject:     condObject("test"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Shift has invalid calc type",
+			Settings: settings{
+				Band:           bandUpper,
+				DonchianConfig: indiDonchian.DonchianConfig{Period: 20},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				CondObject:     condObject(exchange.LastPrice),
+				Shift: tools.Shift{
+					Calc:     tools.Calc{Type: "test"},
+					ShiftVal: decimal.New(1, 0),
+				},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation without Shift",
+			Settings: settings{
+				Band:           bandLower,
+				DonchianConfig: indiDonchian.DonchianConfig{Period: 20},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				CondObject:     condObject(exchange.LastPrice),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Band:           bandUpper,
+				DonchianConfig: indiDonchian.DonchianConfig{Period: 20},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				CondObject:     condObject(exchange.LastPrice),
+				Shift: tools.Shift{
+					Calc:     tools.Calc{Type: tools.CalcPercent},
+					ShiftVal: decimal.New(1, 0),
+				},
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := Donchian{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestDonchianConditionsMet(t *testing.T) {
+	info := indiDonchian.DonchianInfo{
+		Upper:  decimal.New(10, 0),
+		Middle: decimal.New(7, 0),
+		Lower:  decimal.New(4, 0),
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *Donchian
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name:        "Unsuccessful func call when Donchian calc returns error",
+			Tool:        &Donchian{donchian: donchian_mock.NewDonchianMock(indiDonchian.DonchianInfo{}, errors.New("test"), 1, 1)},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when band type is invalid",
+			Tool: &Donchian{
+				donchian: donchian_mock.NewDonchianMock(info, nil, 1, 1),
+				conf:     settings{Band: "test"},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when CondObject is not initialized",
+			Tool: &Donchian{
+				donchian: donchian_mock.NewDonchianMock(info, nil, 1, 1),
+				conf:     settings{Band: bandLower},
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call without Shift",
+			Tool: &Donchian{
+				donchian: donchian_mock.NewDonchianMock(info, nil, 1, 1),
+				conf: settings{
+					Band:       bandUpper,
+					CondObject: condObject(exchange.LastPrice),
+					Cond:       tools.Cond{C: tools.CondAbove},
+				},
+			},
+			Data: exchange.Data{
+				Ticker: exchange.TickerData{
+					LastPrice: decimal.New(11, 0),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					ShiftedBand: decimal.New(10, 0),
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(11, 0),
+					},
+					DonchianInfo: info,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+		{
+			Name: "Successful func call",
+			Tool: &Donchian{
+				donchian: donchian_mock.NewDonchianMock(info, nil, 1, 1),
+				conf: settings{
+					Band:       bandLower,
+					CondObject: condObject(exchange.LastPrice),
+					Cond:       tools.Cond{C: tools.CondBelow},
+					Shift: tools.Shift{
+						Calc:     tools.Calc{Type: tools.CalcUnits},
+						ShiftVal: decimal.New(-1, 0),
+					},
+				},
+			},
+			Data: exchange.Data{
+				Ticker: exchange.TickerData{
+					LastPrice: decimal.New(3, 0),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: false,
+				Data: snapshot{
+					ShiftedBand: decimal.New(3, 0),
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(3, 0),
+					},
+					DonchianInfo: info,
+				},
+			},
+			Result:      false,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestDonchianCandlesCount(t *testing.T) {
+	obj := Donchian{donchian: donchian_mock.NewDonchianMock(indiDonchian.DonchianInfo{}, nil, 3, 2)}
+	assert.Equal(t, 5, obj.CandlesCount())
+}
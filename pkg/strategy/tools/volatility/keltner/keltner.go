@@ -0,0 +1,140 @@
+package keltner
+
+import (
+	"eonbot/pkg/exchange"
+	indiKeltner "eonbot/pkg/strategy/indicators/keltner"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	bandLower = "lower"
+	bandUpper = "upper"
+)
+
+type Keltner struct {
+	keltner  indiKeltner.Keltner
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	Band string `json:"band" conform:"trim,lower"`
+
+	indiKeltner.KeltnerConfig
+	tools.Cond
+	tools.CondObject
+	tools.Shift
+}
+
+type snapshot struct {
+	ShiftedBand decimal.Decimal `json:"shiftedBand"`
+	tools.CondObjectSnapshot
+	indiKeltner.KeltnerInfo
+}
+
+func New(conf func(v interface{}) error) (*Keltner, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	keltner, err := indiKeltner.NewFromConfig(s.KeltnerConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	s.CondObject.AllowTickerPrice()
+	if err := s.CondObject.Init(0); err != nil {
+		return nil, err
+	}
+
+	return &Keltner{
+		keltner: keltner,
+		conf:    s,
+	}, nil
+}
+
+func (k *Keltner) Validate() error {
+	switch k.conf.Band {
+	case bandLower, bandUpper:
+		break
+	default:
+		return errors.New("band type is invalid")
+	}
+
+	if err := k.conf.KeltnerConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.conf.CondObject.Validate(); err != nil {
+		return err
+	}
+
+	// shift is optional.
+	if k.conf.Shift.ShiftVal.IsZero() {
+		return nil
+	}
+
+	if err := k.conf.Shift.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (k *Keltner) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := k.keltner.Calc(d.Candles)
+	if err != nil {
+		k.snapshot.Clear()
+		return false, err
+	}
+
+	var band decimal.Decimal
+	switch k.conf.Band {
+	case bandUpper:
+		band = info.Upper
+	case bandLower:
+		band = info.Lower
+	default:
+		k.snapshot.Clear()
+		return false, errors.New("band type is invalid")
+	}
+
+	val, err := k.conf.CondObject.Value(d)
+	if err != nil {
+		k.snapshot.Clear()
+		return false, err
+	}
+
+	shiftedBand := band
+	if !k.conf.Shift.ShiftVal.IsZero() {
+		shiftedBand = k.conf.Shift.CalcVal(band)
+	}
+
+	isMet := k.conf.Cond.Match(val, shiftedBand)
+
+	k.snapshot.Set(snapshot{
+		ShiftedBand:        shiftedBand,
+		CondObjectSnapshot: k.conf.CondObject.Snapshot(val),
+		KeltnerInfo:        info,
+	}, isMet)
+
+	return isMet, nil
+}
+
+func (k *Keltner) CandlesCount() int {
+	return k.keltner.CandlesCount()
+}
+
+func (k *Keltner) Snapshot() tools.Snapshot {
+	return k.snapshot.Get()
+}
+
+func (k *Keltner) Reset() {}
@@ -0,0 +1,84 @@
+package cmf
+
+import (
+	"eonbot/pkg/exchange"
+	indiCMF "eonbot/pkg/strategy/indicators/cmf"
+	"eonbot/pkg/strategy/tools"
+
+	"github.com/shopspring/decimal"
+)
+
+type CMF struct {
+	cmf      indiCMF.CMF
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	indiCMF.CMFConfig
+	tools.Cond
+	tools.Level
+}
+
+type snapshot struct {
+	CMFVal decimal.Decimal `json:"cmfVal"`
+}
+
+func New(conf func(v interface{}) error) (*CMF, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	cmf, err := indiCMF.NewFromConfig(s.CMFConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Level.Init(decimal.New(-1, 0), decimal.New(1, 0))
+
+	return &CMF{
+		cmf:  cmf,
+		conf: s,
+	}, nil
+}
+
+func (c *CMF) Validate() error {
+	if err := c.conf.CMFConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.conf.Level.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CMF) ConditionsMet(d exchange.Data) (bool, error) {
+	cmf, err := c.cmf.Calc(d.Candles)
+	if err != nil {
+		c.snapshot.Clear()
+		return false, err
+	}
+
+	isMet := c.conf.Cond.Match(cmf, c.conf.Level.LevelVal)
+
+	// collect snapshot data
+	c.snapshot.Set(snapshot{CMFVal: cmf}, isMet)
+	return isMet, nil
+}
+
+func (c *CMF) CandlesCount() int {
+	return c.cmf.CandlesCount()
+}
+
+func (c *CMF) Snapshot() tools.Snapshot {
+	return c.snapshot.Get()
+}
+
+func (c *CMF) Reset() {}
@@ -0,0 +1,183 @@
+package cmf
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/cmf_mock"
+	indiCMF "eonbot/pkg/strategy/indicators/cmf"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func level(val string) tools.Level {
+	l := tools.Level{
+		LevelVal: decimal.RequireFromString(val),
+	}
+	l.Init(decimal.New(-1, 0), decimal.New(1, 0))
+	return l
+}
+
+func TestCMFNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when CMFConfig's period is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.CMFConfig.Period = 10
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCMFValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when CMFConfig has invalid period",
+			Settings: settings{
+				CMFConfig: indiCMF.CMFConfig{Period: 300},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level("0.5"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				CMFConfig: indiCMF.CMFConfig{Period: 10},
+				Cond:      tools.Cond{},
+				Level:     level("0.5"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Level is out of range",
+			Settings: settings{
+				CMFConfig: indiCMF.CMFConfig{Period: 10},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level("101"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				CMFConfig: indiCMF.CMFConfig{Period: 10},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level("0.5"),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := CMF{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCMFConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Tool        *CMF
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful func call when CMF calc returns an error",
+			Tool: &CMF{
+				cmf: cmf_mock.NewCMFMock(decimal.Zero, errors.New("test"), 1, 1),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call",
+			Tool: &CMF{
+				cmf: cmf_mock.NewCMFMock(decimal.RequireFromString("0.5"), nil, 3, 0),
+				conf: settings{
+					Cond:  tools.Cond{C: tools.CondEqual},
+					Level: level("0.5"),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					CMFVal: decimal.RequireFromString("0.5"),
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCMFCandlesCount(t *testing.T) {
+	obj := CMF{cmf: cmf_mock.NewCMFMock(decimal.Zero, nil, 3, 2)}
+	assert.Equal(t, 5, obj.CandlesCount())
+}
@@ -0,0 +1,84 @@
+package mfi
+
+import (
+	"eonbot/pkg/exchange"
+	indiMFI "eonbot/pkg/strategy/indicators/mfi"
+	"eonbot/pkg/strategy/tools"
+
+	"github.com/shopspring/decimal"
+)
+
+type MFI struct {
+	mfi      indiMFI.MFI
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	indiMFI.MFIConfig
+	tools.Cond
+	tools.Level
+}
+
+type snapshot struct {
+	MFIVal decimal.Decimal `json:"mfiVal"`
+}
+
+func New(conf func(v interface{}) error) (*MFI, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	mfi, err := indiMFI.NewFromConfig(s.MFIConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Level.ZeroToHundred()
+
+	return &MFI{
+		mfi:  mfi,
+		conf: s,
+	}, nil
+}
+
+func (m *MFI) Validate() error {
+	if err := m.conf.MFIConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := m.conf.Level.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MFI) ConditionsMet(d exchange.Data) (bool, error) {
+	mfi, err := m.mfi.Calc(d.Candles)
+	if err != nil {
+		m.snapshot.Clear()
+		return false, err
+	}
+
+	isMet := m.conf.Cond.Match(mfi, m.conf.Level.LevelVal)
+
+	// collect snapshot data
+	m.snapshot.Set(snapshot{MFIVal: mfi}, isMet)
+	return isMet, nil
+}
+
+func (m *MFI) CandlesCount() int {
+	return m.mfi.CandlesCount()
+}
+
+func (m *MFI) Snapshot() tools.Snapshot {
+	return m.snapshot.Get()
+}
+
+func (m *MFI) Reset() {}
@@ -0,0 +1,183 @@
+package mfi
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/mfi_mock"
+	indiMFI "eonbot/pkg/strategy/indicators/mfi"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func level(val string) tools.Level {
+	l := tools.Level{
+		LevelVal: decimal.RequireFromString(val),
+	}
+	l.ZeroToHundred()
+	return l
+}
+
+func TestMFINew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when MFIConfig's period is invalid",
+			Conf: func(v interface{}) error {
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.MFIConfig.Period = 10
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestMFIValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when MFIConfig has invalid period",
+			Settings: settings{
+				MFIConfig: indiMFI.MFIConfig{Period: 300},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level("20"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				MFIConfig: indiMFI.MFIConfig{Period: 10},
+				Cond:      tools.Cond{},
+				Level:     level("20"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Level is out of range",
+			Settings: settings{
+				MFIConfig: indiMFI.MFIConfig{Period: 10},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level("101"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				MFIConfig: indiMFI.MFIConfig{Period: 10},
+				Cond:      tools.Cond{C: tools.CondAbove},
+				Level:     level("20"),
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := MFI{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestMFIConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Tool        *MFI
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful func call when MFI calc returns an error",
+			Tool: &MFI{
+				mfi: mfi_mock.NewMFIMock(decimal.Zero, errors.New("test"), 1, 1),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call",
+			Tool: &MFI{
+				mfi: mfi_mock.NewMFIMock(decimal.RequireFromString("20"), nil, 3, 0),
+				conf: settings{
+					Cond:  tools.Cond{C: tools.CondEqual},
+					Level: level("20"),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					MFIVal: decimal.RequireFromString("20"),
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestMFICandlesCount(t *testing.T) {
+	obj := MFI{mfi: mfi_mock.NewMFIMock(decimal.Zero, nil, 3, 2)}
+	assert.Equal(t, 6, obj.CandlesCount())
+}
@@ -0,0 +1,78 @@
+package obv
+
+import (
+	"eonbot/pkg/exchange"
+	indiOBV "eonbot/pkg/strategy/indicators/obv"
+	"eonbot/pkg/strategy/tools"
+
+	"github.com/shopspring/decimal"
+)
+
+type OBV struct {
+	obv      indiOBV.OBV
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	LevelVal decimal.Decimal `json:"levelVal"`
+	indiOBV.OBVConfig
+	tools.Cond
+}
+
+type snapshot struct {
+	OBVVal decimal.Decimal `json:"obvVal"`
+}
+
+func New(conf func(v interface{}) error) (*OBV, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	obv, err := indiOBV.NewFromConfig(s.OBVConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OBV{
+		obv:  obv,
+		conf: s,
+	}, nil
+}
+
+func (o *OBV) Validate() error {
+	if err := o.conf.OBVConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *OBV) ConditionsMet(d exchange.Data) (bool, error) {
+	obv, err := o.obv.Calc(d.Candles)
+	if err != nil {
+		o.snapshot.Clear()
+		return false, err
+	}
+
+	isMet := o.conf.Cond.Match(obv, o.conf.LevelVal)
+
+	// collect snapshot data
+	o.snapshot.Set(snapshot{OBVVal: obv}, isMet)
+	return isMet, nil
+}
+
+func (o *OBV) CandlesCount() int {
+	return o.obv.CandlesCount()
+}
+
+func (o *OBV) Snapshot() tools.Snapshot {
+	return o.snapshot.Get()
+}
+
+func (o *OBV) Reset() {}
@@ -0,0 +1,167 @@
+package obv
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/obv_mock"
+	indiOBV "eonbot/pkg/strategy/indicators/obv"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOBVNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when OBVConfig's volume type is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.OBVConfig.Period = 10
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.OBVConfig.Period = 10
+				val.OBVConfig.Volume = exchange.BaseVolume
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestOBVValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when OBVConfig has invalid volume type",
+			Settings: settings{
+				OBVConfig: indiOBV.OBVConfig{Period: 10, Volume: "test"},
+				Cond:      tools.Cond{C: tools.CondAbove},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				OBVConfig: indiOBV.OBVConfig{Period: 10, Volume: exchange.BaseVolume},
+				Cond:      tools.Cond{},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				LevelVal:  decimal.New(-1000, 0),
+				OBVConfig: indiOBV.OBVConfig{Period: 10, Volume: exchange.BaseVolume},
+				Cond:      tools.Cond{C: tools.CondAbove},
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := OBV{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestOBVConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Tool        *OBV
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful func call when OBV calc returns an error",
+			Tool: &OBV{
+				obv: obv_mock.NewOBVMock(decimal.Zero, errors.New("test"), 1, 1),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call",
+			Tool: &OBV{
+				obv: obv_mock.NewOBVMock(decimal.New(-50, 0), nil, 3, 0),
+				conf: settings{
+					LevelVal: decimal.Zero,
+					Cond:     tools.Cond{C: tools.CondBelow},
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					OBVVal: decimal.New(-50, 0),
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestOBVCandlesCount(t *testing.T) {
+	obj := OBV{obv: obv_mock.NewOBVMock(decimal.Zero, nil, 3, 2)}
+	assert.Equal(t, 6, obj.CandlesCount())
+}
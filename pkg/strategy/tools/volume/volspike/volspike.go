@@ -0,0 +1,88 @@
+package volspike
+
+import (
+	"eonbot/pkg/exchange"
+	indiVolSpike "eonbot/pkg/strategy/indicators/volspike"
+	"eonbot/pkg/strategy/tools"
+
+	"github.com/shopspring/decimal"
+)
+
+type VolSpike struct {
+	volSpike indiVolSpike.VolSpike
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	Differ decimal.Decimal `json:"diff"`
+	indiVolSpike.VolSpikeConfig
+	tools.Cond
+	tools.Diff
+}
+
+type snapshot struct {
+	Diff decimal.Decimal `json:"diffVal"`
+	indiVolSpike.VolSpikeInfo
+}
+
+func New(conf func(v interface{}) error) (*VolSpike, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	volSpike, err := indiVolSpike.NewFromConfig(s.VolSpikeConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VolSpike{
+		volSpike: volSpike,
+		conf:     s,
+	}, nil
+}
+
+func (v *VolSpike) Validate() error {
+	if err := v.conf.VolSpikeConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := v.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := v.conf.Diff.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *VolSpike) ConditionsMet(d exchange.Data) (bool, error) {
+	info, err := v.volSpike.Calc(d.Candles)
+	if err != nil {
+		v.snapshot.Clear()
+		return false, err
+	}
+
+	diff := v.conf.Diff.Diff(info.AvgVolume, info.Volume)
+	isMet := v.conf.Cond.Match(diff, v.conf.Differ)
+
+	// collect snapshot data
+	v.snapshot.Set(snapshot{
+		Diff:         diff,
+		VolSpikeInfo: info,
+	}, isMet)
+	return isMet, nil
+}
+
+func (v *VolSpike) CandlesCount() int {
+	return v.volSpike.CandlesCount()
+}
+
+func (v *VolSpike) Snapshot() tools.Snapshot {
+	return v.snapshot.Get()
+}
+
+func (v *VolSpike) Reset() {}
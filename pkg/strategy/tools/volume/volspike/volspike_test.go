@@ -0,0 +1,191 @@
+package volspike
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/volspike_mock"
+	indiVolSpike "eonbot/pkg/strategy/indicators/volspike"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolSpikeNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when VolSpikeConfig's volume type is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.VolSpikeConfig.Period = 10
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.VolSpikeConfig.Period = 10
+				val.VolSpikeConfig.Volume = exchange.CounterVolume
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVolSpikeValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when VolSpikeConfig has invalid period",
+			Settings: settings{
+				VolSpikeConfig: indiVolSpike.VolSpikeConfig{Period: 300, Volume: exchange.BaseVolume},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				Diff:           tools.Diff{Calc: tools.Calc{Type: tools.CalcPercent}},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				VolSpikeConfig: indiVolSpike.VolSpikeConfig{Period: 10, Volume: exchange.BaseVolume},
+				Cond:           tools.Cond{},
+				Diff:           tools.Diff{Calc: tools.Calc{Type: tools.CalcPercent}},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Diff has invalid calc type",
+			Settings: settings{
+				VolSpikeConfig: indiVolSpike.VolSpikeConfig{Period: 10, Volume: exchange.BaseVolume},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				Diff:           tools.Diff{Calc: tools.Calc{Type: "test"}},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				Differ:         decimal.New(200, 0),
+				VolSpikeConfig: indiVolSpike.VolSpikeConfig{Period: 10, Volume: exchange.BaseVolume},
+				Cond:           tools.Cond{C: tools.CondAbove},
+				Diff:           tools.Diff{Calc: tools.Calc{Type: tools.CalcPercent}},
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := VolSpike{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVolSpikeConditionsMet(t *testing.T) {
+	info := indiVolSpike.VolSpikeInfo{
+		Volume:    decimal.New(30, 0),
+		AvgVolume: decimal.New(10, 0),
+	}
+
+	tests := []struct {
+		Name        string
+		Tool        *VolSpike
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful func call when volume spike calc returns an error",
+			Tool: &VolSpike{
+				volSpike: volspike_mock.NewVolSpikeMock(indiVolSpike.VolSpikeInfo{}, errors.New("test"), 1, 1),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call",
+			Tool: &VolSpike{
+				volSpike: volspike_mock.NewVolSpikeMock(info, nil, 3, 0),
+				conf: settings{
+					Differ: decimal.New(150, 0),
+					Cond:   tools.Cond{C: tools.CondAbove},
+					Diff:   tools.Diff{Calc: tools.Calc{Type: tools.CalcPercent}},
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					Diff:         decimal.New(200, 0),
+					VolSpikeInfo: info,
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			snap := v.Tool.Snapshot()
+			assert.Equal(t, v.Snapshot.CondsMet, snap.CondsMet)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+				assert.Nil(t, snap.Data)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.True(t, v.Snapshot.Data.(snapshot).Diff.Equal(snap.Data.(snapshot).Diff))
+			assert.Equal(t, v.Snapshot.Data.(snapshot).VolSpikeInfo, snap.Data.(snapshot).VolSpikeInfo)
+		})
+	}
+}
+
+func TestVolSpikeCandlesCount(t *testing.T) {
+	obj := VolSpike{volSpike: volspike_mock.NewVolSpikeMock(indiVolSpike.VolSpikeInfo{}, nil, 3, 2)}
+	assert.Equal(t, 6, obj.CandlesCount())
+}
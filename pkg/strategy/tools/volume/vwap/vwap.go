@@ -0,0 +1,118 @@
+package vwap
+
+import (
+	"eonbot/pkg/exchange"
+	indiVWAP "eonbot/pkg/strategy/indicators/vwap"
+	"eonbot/pkg/strategy/tools"
+
+	"github.com/shopspring/decimal"
+)
+
+type VWAP struct {
+	vwap     indiVWAP.VWAP
+	conf     settings
+	snapshot tools.SnapshotManager
+}
+
+type settings struct {
+	indiVWAP.VWAPConfig
+	tools.Cond
+	tools.CondObject
+	tools.Shift
+}
+
+type snapshot struct {
+	VWAPVal        decimal.Decimal `json:"vwapVal"`
+	ShiftedVWAPVal decimal.Decimal `json:"shiftedVWAPVal"`
+	tools.CondObjectSnapshot
+}
+
+func New(conf func(v interface{}) error) (*VWAP, error) {
+	var s settings
+	if err := conf(&s); err != nil {
+		return nil, err
+	}
+
+	vwap, err := indiVWAP.NewFromConfig(s.VWAPConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	s.CondObject.AllowTickerPrice()
+	s.CondObject.AllowCandlePrice()
+	if err := s.CondObject.Init(0); err != nil {
+		return nil, err
+	}
+
+	return &VWAP{
+		vwap: vwap,
+		conf: s,
+	}, nil
+}
+
+func (v *VWAP) Validate() error {
+	if err := v.conf.VWAPConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := v.conf.Cond.Validate(); err != nil {
+		return err
+	}
+
+	if err := v.conf.CondObject.Validate(); err != nil {
+		return err
+	}
+
+	// shift is optional.
+	if v.conf.Shift.ShiftVal.IsZero() {
+		return nil
+	}
+
+	if err := v.conf.Shift.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *VWAP) ConditionsMet(d exchange.Data) (bool, error) {
+	vwap, err := v.vwap.Calc(d.Candles)
+	if err != nil {
+		v.snapshot.Clear()
+		return false, err
+	}
+
+	val, err := v.conf.CondObject.Value(d)
+	if err != nil {
+		v.snapshot.Clear()
+		return false, err
+	}
+
+	shiftedVWAP := vwap
+	if !v.conf.Shift.ShiftVal.IsZero() {
+		shiftedVWAP = v.conf.Shift.CalcVal(vwap)
+	}
+
+	isMet := v.conf.Cond.Match(val, shiftedVWAP)
+
+	// collect snapshot data
+	v.snapshot.Set(snapshot{
+		VWAPVal:            vwap,
+		ShiftedVWAPVal:     shiftedVWAP,
+		CondObjectSnapshot: v.conf.CondObject.Snapshot(val),
+	}, isMet)
+	return isMet, nil
+}
+
+func (v *VWAP) CandlesCount() int {
+	if v.conf.CondObject.CandlesCount() > v.vwap.CandlesCount() {
+		return v.conf.CondObject.CandlesCount()
+	}
+	return v.vwap.CandlesCount()
+}
+
+func (v *VWAP) Snapshot() tools.Snapshot {
+	return v.snapshot.Get()
+}
+
+func (v *VWAP) Reset() {}
@@ -0,0 +1,273 @@
+package vwap
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy/indicators/all_mocks/vwap_mock"
+	indiVWAP "eonbot/pkg/strategy/indicators/vwap"
+	"eonbot/pkg/strategy/tools"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func condObject(obj string) tools.CondObject {
+	val := tools.CondObject{
+		Obj: obj,
+	}
+	val.AllowTickerPrice()
+	val.AllowCandlePrice()
+	val.Init(0)
+	return val
+}
+
+func TestVWAPNew(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Conf        func(v interface{}) error
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful creation when passed function returns error",
+			Conf: func(v interface{}) error {
+				return errors.New("test")
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when VWAPConfig's period is invalid",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.CondObject.Obj = exchange.LastPrice
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful creation when CondObject has invalid object",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.VWAPConfig.Period = 10
+				val.CondObject.Obj = "test"
+				return nil
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful creation",
+			Conf: func(v interface{}) error {
+				val := v.(*settings)
+				val.VWAPConfig.Period = 10
+				val.CondObject.Obj = exchange.ClosePrice
+				return nil
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := New(v.Conf)
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVWAPValidate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Settings    settings
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful validation when VWAPConfig has invalid period",
+			Settings: settings{
+				VWAPConfig: indiVWAP.VWAPConfig{Period: 300},
+				Cond:       tools.Cond{C: tools.CondAbove},
+				CondObject: condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Cond has no possible conditions",
+			Settings: settings{
+				VWAPConfig: indiVWAP.VWAPConfig{Period: 10},
+				Cond:       tools.Cond{},
+				CondObject: condObject(exchange.LastPrice),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when CondObject has invalid object",
+			Settings: settings{
+				VWAPConfig: indiVWAP.VWAPConfig{Period: 10},
+				Cond:       tools.Cond{C: tools.CondAbove},
+				CondObject: condObject("test"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful validation when Shift has invalid calc type",
+			Settings: settings{
+				VWAPConfig: indiVWAP.VWAPConfig{Period: 10},
+				Cond:       tools.Cond{C: tools.CondAbove},
+				CondObject: condObject(exchange.LastPrice),
+				Shift: tools.Shift{
+					Calc:     tools.Calc{Type: "test"},
+					ShiftVal: decimal.New(1, 0),
+				},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Successful validation without Shift",
+			Settings: settings{
+				VWAPConfig: indiVWAP.VWAPConfig{Period: 10},
+				Cond:       tools.Cond{C: tools.CondAbove},
+				CondObject: condObject(exchange.LastPrice),
+			},
+			ShouldError: false,
+		},
+		{
+			Name: "Successful validation",
+			Settings: settings{
+				VWAPConfig: indiVWAP.VWAPConfig{Period: 10},
+				Cond:       tools.Cond{C: tools.CondAbove},
+				CondObject: condObject(exchange.LastPrice),
+				Shift: tools.Shift{
+					Calc:     tools.Calc{Type: tools.CalcPercent},
+					ShiftVal: decimal.New(1, 0),
+				},
+			},
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			obj := VWAP{conf: v.Settings}
+			err := obj.Validate()
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVWAPConditionsMet(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Tool        *VWAP
+		Data        exchange.Data
+		Snapshot    tools.Snapshot
+		Result      bool
+		ShouldError bool
+	}{
+		{
+			Name: "Unsuccessful func call when VWAP calc returns an error",
+			Tool: &VWAP{
+				vwap: vwap_mock.NewVWAPMock(decimal.Zero, errors.New("test"), 1, 1),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Unsuccessful func call when CondObject is not initialized",
+			Tool: &VWAP{
+				vwap: vwap_mock.NewVWAPMock(decimal.Zero, nil, 1, 1),
+			},
+			Result:      false,
+			ShouldError: true,
+		},
+		{
+			Name: "Successful func call",
+			Tool: &VWAP{
+				vwap: vwap_mock.NewVWAPMock(decimal.New(10, 0), nil, 3, 0),
+				conf: settings{
+					Cond:       tools.Cond{C: tools.CondAbove},
+					CondObject: condObject(exchange.LastPrice),
+					Shift: tools.Shift{
+						Calc:     tools.Calc{Type: tools.CalcUnits},
+						ShiftVal: decimal.New(1, 0),
+					},
+				},
+			},
+			Data: exchange.Data{
+				Ticker: exchange.TickerData{
+					LastPrice: decimal.New(12, 0),
+				},
+			},
+			Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: snapshot{
+					VWAPVal:        decimal.New(10, 0),
+					ShiftedVWAPVal: decimal.New(11, 0),
+					CondObjectSnapshot: tools.CondObjectSnapshot{
+						Value: decimal.New(12, 0),
+					},
+				},
+			},
+			Result:      true,
+			ShouldError: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			res, err := v.Tool.ConditionsMet(v.Data)
+			assert.Equal(t, v.Result, res)
+			assert.Equal(t, v.Snapshot, v.Tool.Snapshot())
+			if v.ShouldError {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVWAPCandlesCount(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Tool   *VWAP
+		Result int
+	}{
+		{
+			Name: "Successful candles count return from VWAP",
+			Tool: &VWAP{
+				vwap: vwap_mock.NewVWAPMock(decimal.Zero, nil, 3, 2),
+				conf: settings{CondObject: condObject(exchange.LastPrice)},
+			},
+			Result: 5,
+		},
+		{
+			Name: "Successful candles count return from CondObject",
+			Tool: &VWAP{
+				vwap: vwap_mock.NewVWAPMock(decimal.Zero, nil, 0, 0),
+				conf: settings{CondObject: condObject(exchange.ClosePrice)},
+			},
+			Result: 1,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, v.Result, v.Tool.CandlesCount())
+		})
+	}
+}
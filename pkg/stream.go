@@ -91,6 +91,12 @@ func (s *StreamCycle) UnmarshalJSON(d []byte) error {
 			return err
 		}
 		s.Result = res
+	case SuspendedResType:
+		res := &SuspendedResult{}
+		if err := json.Unmarshal(tmp.Result, res); err != nil {
+			return err
+		}
+		s.Result = res
 	default:
 		return errors.New("result type is invalid")
 	}
@@ -101,6 +107,7 @@ func (s *StreamCycle) UnmarshalJSON(d []byte) error {
 const (
 	StrategiesResType = "strategies"
 	OpenOrdersResType = "open-orders"
+	SuspendedResType  = "suspended"
 )
 
 // Resulter is the interface implemented by
@@ -126,6 +133,10 @@ type StrategiesResult struct {
 	// Snapshots specifies a map of each strategy's
 	// snapshots.
 	Snapshots map[string]strategy.Snapshot `json:"snapshots"`
+
+	// Throttled specifies strategies that were not
+	// allowed to act because of their cooldown.
+	Throttled []string `json:"throttled,omitempty"`
 }
 
 // NewStrategiesResult creates new strategies Resulter
@@ -139,6 +150,7 @@ func NewSrategiesResult(snapshots map[string]strategy.Snapshot) *StrategiesResul
 			ResultType: StrategiesResType,
 		},
 		snapshots,
+		nil,
 	}
 }
 
@@ -179,3 +191,27 @@ func NewOpenOrdersResult(total, open, cancelled int) *OpenOrdersResult {
 func (o *OpenOrdersResult) Type() string {
 	return o.ResultType
 }
+
+// SuspendedResult specifies that strategies were not
+// executed during the cycle.
+type SuspendedResult struct {
+	ResultCore
+
+	// Reason specifies why strategies were not executed.
+	Reason string `json:"reason"`
+}
+
+// NewSuspendedResult creates new suspended Resulter
+// implementation object.
+func NewSuspendedResult(reason string) *SuspendedResult {
+	return &SuspendedResult{
+		ResultCore{
+			ResultType: SuspendedResType,
+		},
+		reason,
+	}
+}
+
+func (s *SuspendedResult) Type() string {
+	return s.ResultType
+}
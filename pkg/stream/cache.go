@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"bytes"
 	"eonbot/pkg/exchange"
 	"time"
 )
@@ -17,15 +18,36 @@ type cache struct {
 	// unconfirmed specifies open order
 	// that the bot waits to be filled.
 	unconfirmed unconfirmed
+
+	// states specifies the latest saved (or restored)
+	// serialized strategies states by strategy name.
+	states map[string][]byte
 }
 
 // newCache creates new cache pointer.
 func newCache() *cache {
 	return &cache{
 		openOrders: make(map[string]time.Time),
+		states:     make(map[string][]byte),
 	}
 }
 
+/*
+   Strategies states cache
+*/
+
+// setState sets the latest saved strategy's state.
+func (c *cache) setState(strat string, state []byte) {
+	c.states[strat] = state
+}
+
+// stateChanged returns true if strategy's state differs
+// from the latest saved one.
+func (c *cache) stateChanged(strat string, state []byte) bool {
+	saved, ok := c.states[strat]
+	return !ok || !bytes.Equal(saved, state)
+}
+
 /*
    Open orders cache
 */
@@ -1,5 +1,7 @@
 package stream
 
+import "eonbot/pkg/event"
+
 // CancelAll submits cancellation requests for
 // all open orders of this stream's pair.
 func (s *Stream) CancelAll() error {
@@ -20,6 +22,7 @@ func (s *Stream) CancelAll() error {
 		if err := s.Exchange.CancelOrder(s.Pair, ord.ID); err != nil {
 			return s.prepError(err)
 		}
+		s.orderEvent(event.OrderCancelled, ord, "")
 	}
 
 	return nil
@@ -0,0 +1,30 @@
+package stream
+
+import (
+	"eonbot/pkg/event"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/metrics"
+)
+
+// orderMetricEvents maps order event types to
+// orders metric's event label values.
+var orderMetricEvents = map[event.Type]string{
+	event.OrderPlaced:    metrics.OrderPlaced,
+	event.OrderFilled:    metrics.OrderFilled,
+	event.OrderCancelled: metrics.OrderCancelled,
+}
+
+// orderEvent publishes order's event and
+// updates orders metric.
+func (s *Stream) orderEvent(t event.Type, ord exchange.Order, strat string) {
+	metrics.Orders.Inc(s.Pair.String(), ord.Side, orderMetricEvents[t])
+
+	s.Events.Publish(t, event.OrderPayload{
+		Pair:     s.Pair.String(),
+		ID:       ord.ID,
+		Side:     ord.Side,
+		Rate:     ord.Rate,
+		Amount:   ord.Amount,
+		Strategy: strat,
+	})
+}
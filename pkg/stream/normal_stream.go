@@ -2,7 +2,9 @@ package stream
 
 import (
 	"eonbot/pkg"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
+	"eonbot/pkg/metrics"
 	"eonbot/pkg/strategy"
 	"errors"
 	"fmt"
@@ -114,6 +116,7 @@ func (s *Stream) handleOpenOrders() (pkg.Resulter, error) {
 
 		// after cancellation, remove open order from the cache.
 		s.cache.removeOpenOrder(ord.ID)
+		s.orderEvent(event.OrderCancelled, ord, "")
 
 		// increase cancelled orders count.
 		cancelled++
@@ -152,6 +155,7 @@ func (s *Stream) confirmOrder() error {
 
 			// increment order since start count.
 			s.DB.InMemory().IncrOrdersSinceStart()
+			s.orderEvent(event.OrderFilled, ord, unconf.strategy)
 		} else {
 			// since no more open orders are left, this is probably some error in exchange side,
 			// so just cancel it.
@@ -173,6 +177,12 @@ func (s *Stream) handleStrategies(bal BalancesPair) (pkg.Resulter, error) {
 		return nil, s.prepError(err)
 	}
 
+	// buy mode strategies are suspended outside
+	// of the pair's trading schedule.
+	if mode(ticker.BidPrice, bal.Base, s.Pair.MinValue) == buyMode && !s.scheduleActive() {
+		return pkg.NewSuspendedResult("outside of trading schedule"), nil
+	}
+
 	// get candles count.
 	count := s.candlesCount(ticker, bal)
 
@@ -185,6 +195,28 @@ func (s *Stream) handleStrategies(bal BalancesPair) (pkg.Resulter, error) {
 	// group collected data.
 	data := exchange.NewData(ticker, candles)
 
+	// retrieve order book from exchange only if
+	// at least one of the strategies needs it.
+	if depth := s.orderBookDepth(ticker, bal); depth > 0 {
+		book, err := s.Exchange.GetOrderBook(s.Pair, depth)
+		if err != nil {
+			return nil, s.prepError(err)
+		}
+
+		data.OrderBook = book
+	}
+
+	// retrieve recent trades from exchange only if
+	// at least one of the strategies needs them.
+	if window := s.tradesWindow(ticker, bal); window > 0 {
+		trades, err := s.Exchange.GetTrades(s.Pair, time.Now().UTC().Add(-window))
+		if err != nil {
+			return nil, s.prepError(err)
+		}
+
+		data.Trades = trades
+	}
+
 	// if sell mode is active, retrieve and calculate buy price.
 	if mode(ticker.BidPrice, bal.Base, s.Pair.MinValue) == sellMode {
 		buyPrice, err := s.prepBuyPrice(bal)
@@ -208,8 +240,32 @@ func (s *Stream) act(data exchange.Data, bal BalancesPair) (pkg.Resulter, error)
 		return nil, s.prepError(fmt.Errorf("strategies for %s mode are not specified", mode(data.Ticker.BidPrice, bal.Base, s.Pair.MinValue)))
 	}
 
+	// retrieve recently filled orders needed
+	// to check throttling limits.
+	now := time.Now().UTC()
+	orders, err := s.recentOrders(now)
+	if err != nil {
+		return nil, s.prepError(err)
+	}
+
+	// throttling limits only re-entry,
+	// exits are never throttled.
+	buying := mode(data.Ticker.BidPrice, bal.Base, s.Pair.MinValue) == buyMode
+
+	// buy mode strategies are suspended until
+	// pair's throttling limits allow re-entry.
+	if buying {
+		if reason := pairThrottled(s.Conf.Config.Throttle, orders, data.Ticker.LastPrice, now); reason != "" {
+			return pkg.NewSuspendedResult(reason), nil
+		}
+	}
+
 	res := pkg.NewSrategiesResult(nil)
 
+	// save strategies states, so that they could
+	// be restored after restart / reload.
+	defer s.saveStates(strats)
+
 	// loop over all strategies and
 	// check if they allow to activate outcomes.
 	for _, str := range strats {
@@ -219,12 +275,20 @@ func (s *Stream) act(data exchange.Data, bal BalancesPair) (pkg.Resulter, error)
 			res.Snapshots[str.Name()] = str.Snapshot()
 		}
 
+		// skip strategy in buy mode if its own
+		// cooldown is still active.
+		if buying && strategyThrottled(s.Conf.Config.Throttle, orders, str.Name(), now) {
+			res.Throttled = append(res.Throttled, str.Name())
+			continue
+		}
+
 		// check if strategy allows to
 		// activate outcome.
 		ready, err := str.ReadyToAct(data)
 		if err != nil {
 			return nil, s.prepError(err)
 		}
+		s.conditionsMetMetric(str.Name(), ready)
 
 		// if strategy does not allow outcomes activation,
 		// make its snapshot and go onto the next one.
@@ -233,10 +297,15 @@ func (s *Stream) act(data exchange.Data, bal BalancesPair) (pkg.Resulter, error)
 			continue
 		}
 
+		s.Events.Publish(event.StrategyFired, event.StrategyPayload{
+			Pair:     s.Pair.String(),
+			Strategy: str.Name(),
+		})
+
 		// loop over strategy's outcomes and
 		// handle every single one of them.
 		for _, out := range str.Outcomes() {
-			if err := s.activateOutcome(out, data.Ticker, bal, str.Name()); err != nil {
+			if err := s.activateOutcome(out, data, bal, str); err != nil {
 				return nil, s.prepError(err)
 			}
 		}
@@ -261,14 +330,59 @@ func (s *Stream) act(data exchange.Data, bal BalancesPair) (pkg.Resulter, error)
 func (s *Stream) candlesCount(ticker exchange.TickerData, bal BalancesPair) int {
 	var count int
 	for _, str := range s.strategiesByMode(ticker, bal) {
-		if str.CandlesNeeded() > count {
-			count = str.CandlesNeeded()
+		if candles := str.CandlesNeeded(); candles > count {
+			count = candles
 		}
 	}
 
 	return count
 }
 
+// orderBookDepth loops over strategies used by the stream in current mode
+// and finds the max order book depth needed.
+func (s *Stream) orderBookDepth(ticker exchange.TickerData, bal BalancesPair) int {
+	var depth int
+	for _, str := range s.strategiesByMode(ticker, bal) {
+		if str.OrderBookDepthNeeded() > depth {
+			depth = str.OrderBookDepthNeeded()
+		}
+	}
+
+	return depth
+}
+
+// tradesWindow loops over strategies used by the stream in current mode
+// and finds the longest recent trades time window needed.
+func (s *Stream) tradesWindow(ticker exchange.TickerData, bal BalancesPair) time.Duration {
+	var window time.Duration
+	for _, str := range s.strategiesByMode(ticker, bal) {
+		if str.TradesWindowNeeded() > window {
+			window = str.TradesWindowNeeded()
+		}
+	}
+
+	return window
+}
+
+// conditionsMetMetric updates strategy's conditions
+// met gauge.
+func (s *Stream) conditionsMetMetric(strat string, met bool) {
+	var val float64
+	if met {
+		val = 1
+	}
+	metrics.StrategyConditionsMet.Set(val, s.Pair.String(), strat)
+}
+
+// scheduleActive checks whether the pair's trading
+// schedule allows buy mode strategies to be executed.
+func (s *Stream) scheduleActive() bool {
+	if s.Conf.Config.Schedule == nil {
+		return true
+	}
+	return s.Conf.Config.Schedule.Active(time.Now())
+}
+
 // strategiesByMode gathers all strategies of current active mode.
 func (s *Stream) strategiesByMode(ticker exchange.TickerData, bal BalancesPair) []*strategy.Strategy {
 	if mode := mode(ticker.BidPrice, bal.Base, s.Pair.MinValue); mode == buyMode {
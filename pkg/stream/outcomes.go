@@ -1,25 +1,31 @@
 package stream
 
 import (
+	"encoding/json"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy"
 	"eonbot/pkg/strategy/outcome"
 	"errors"
 	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
 )
 
 // activateOutcome determines the type of the outcome and calls its handler.
-func (s *Stream) activateOutcome(out *outcome.Outcome, ticker exchange.TickerData, bal BalancesPair, strategy string) error {
+func (s *Stream) activateOutcome(out *outcome.Outcome, data exchange.Data, bal BalancesPair, str *strategy.Strategy) error {
 	switch outConf := out.Conf.(type) {
 	case *outcome.Buy:
-		return s.buyOutcome(outConf, ticker, bal, strategy)
+		return s.buyOutcome(outConf, data.Ticker, bal, str.Name())
 	case *outcome.Sell:
-		return s.sellOutcome(outConf, ticker, bal, strategy)
+		return s.sellOutcome(outConf, data.Ticker, bal, str.Name())
 	case *outcome.DCA:
-		return s.dcaOutcome(outConf, ticker, bal, strategy)
+		return s.dcaOutcome(outConf, data.Ticker, bal, str.Name())
 	case *outcome.Telegram:
-		return s.telegramOutcome(outConf, ticker, bal)
+		return s.telegramOutcome(outConf, data, bal, str)
 	case *outcome.Sandbox:
-		return s.sandboxOutcome(outConf, ticker, bal)
+		return s.sandboxOutcome(outConf, data.Ticker, bal)
 	default:
 		return errors.New("outcome type is invalid")
 	}
@@ -53,6 +59,8 @@ func (s *Stream) buyOutcome(buy *outcome.Buy, ticker exchange.TickerData, bal Ba
 		return err
 	}
 
+	s.orderEvent(event.OrderPlaced, exchange.Order{ID: id, Side: exchange.OrderSideBuy, Rate: rate, Amount: amount}, strategy)
+
 	// cache order for later use.
 	s.cache.setUnconfirmed(id, exchange.OrderSideBuy, strategy, nil)
 
@@ -81,6 +89,8 @@ func (s *Stream) sellOutcome(sell *outcome.Sell, ticker exchange.TickerData, bal
 		return err
 	}
 
+	s.orderEvent(event.OrderPlaced, exchange.Order{ID: id, Side: exchange.OrderSideSell, Rate: rate, Amount: amount}, strategy)
+
 	// cache order for later use.
 	s.cache.setUnconfirmed(id, exchange.OrderSideSell, strategy, nil)
 
@@ -120,6 +130,8 @@ func (s *Stream) dcaOutcome(dca *outcome.DCA, ticker exchange.TickerData, bal Ba
 		return err
 	}
 
+	s.orderEvent(event.OrderPlaced, exchange.Order{ID: id, Side: exchange.OrderSideBuy, Rate: rate, Amount: amount}, strategy)
+
 	// cache order for later use.
 	s.cache.setUnconfirmed(id, exchange.OrderSideBuy, strategy, func() {
 		// when order is confirmed increment dca orders count.
@@ -129,12 +141,65 @@ func (s *Stream) dcaOutcome(dca *outcome.DCA, ticker exchange.TickerData, bal Ba
 	return nil
 }
 
-// telegramOutcome publishes message to telegram.
-func (s *Stream) telegramOutcome(tg *outcome.Telegram, ticker exchange.TickerData, bal BalancesPair) error {
-	s.RC.TelegramSend(tg.Msg())
+// telegramOutcome publishes message to telegram. Message is executed as a
+// template with strategy's and market's context.
+func (s *Stream) telegramOutcome(tg *outcome.Telegram, data exchange.Data, bal BalancesPair, str *strategy.Strategy) error {
+	// message failure must not prevent other outcomes from being
+	// activated, so the raw message is sent instead.
+	msg, err := tg.Msg(msgContext(s.Pair.String(), str.Name(), data, bal, str.Snapshot()))
+	if err != nil {
+		logrus.StandardLogger().WithField("action", "telegram outcome message preparation").Error(s.prepError(err))
+	}
+
+	s.Events.Publish(event.OutcomeMessage, event.MessagePayload{
+		Pair:     s.Pair.String(),
+		Strategy: str.Name(),
+		Msg:      msg,
+	})
 	return nil
 }
 
+// msgContext prepares telegram message template's context.
+func msgContext(pair, strat string, data exchange.Data, bal BalancesPair, snap strategy.Snapshot) outcome.MsgContext {
+	ctx := outcome.MsgContext{
+		Pair:     pair,
+		Strategy: strat,
+		Ticker: outcome.MsgTicker{
+			Last:          data.Ticker.LastPrice,
+			Ask:           data.Ticker.AskPrice,
+			Bid:           data.Ticker.BidPrice,
+			BaseVolume:    data.Ticker.BaseVolume,
+			CounterVolume: data.Ticker.CounterVolume,
+			DayChange:     data.Ticker.DayPercentChange,
+		},
+		Balance: outcome.MsgBalance{
+			Base:    bal.Base,
+			Counter: bal.Counter,
+		},
+		BuyPrice: data.BuyPrice,
+		Tools:    make(map[string]interface{}),
+	}
+
+	if data.BuyPrice.IsPositive() {
+		ctx.Profit = data.Ticker.BidPrice.Sub(data.BuyPrice).Div(data.BuyPrice).Mul(decimal.New(100, 0)).Round(2)
+	}
+
+	for id, full := range snap.Tools {
+		// snapshot data is converted to a map, so that its
+		// fields could be accessed by their JSON names.
+		vals := make(map[string]interface{})
+		if d, err := json.Marshal(full.Snapshot.Data); err == nil {
+			if err := json.Unmarshal(d, &vals); err != nil || vals == nil {
+				vals = make(map[string]interface{})
+			}
+		}
+		vals["condsMet"] = full.Snapshot.CondsMet
+		ctx.Tools[id] = vals
+	}
+
+	return ctx
+}
+
 // sandboxOutcome does nothing and can be used as a placeholder or for testing purposes.
 func (s *Stream) sandboxOutcome(sandbox *outcome.Sandbox, ticker exchange.TickerData, bal BalancesPair) error {
 	return nil
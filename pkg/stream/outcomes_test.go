@@ -0,0 +1,51 @@
+package stream
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/strategy"
+	"eonbot/pkg/strategy/tools"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgContext(t *testing.T) {
+	data := exchange.Data{
+		Ticker: exchange.TickerData{
+			LastPrice: decimal.New(11, 0),
+			AskPrice:  decimal.New(12, 0),
+			BidPrice:  decimal.New(11, 0),
+		},
+		BuyPrice: decimal.New(8, 0),
+	}
+	bal := BalancesPair{Base: decimal.New(2, 0), Counter: decimal.New(5, 0)}
+	snap := strategy.Snapshot{
+		Tools: map[string]tools.FullSnapshot{
+			"rsi1": {Type: "rsi", Snapshot: tools.Snapshot{
+				CondsMet: true,
+				Data: struct {
+					RSIVal decimal.Decimal `json:"rsiVal"`
+				}{decimal.New(285, -1)},
+			}},
+			"level1": {Type: "level"},
+		},
+	}
+
+	ctx := msgContext("ETH_BTC", "exit", data, bal, snap)
+	assert.Equal(t, "ETH_BTC", ctx.Pair)
+	assert.Equal(t, "exit", ctx.Strategy)
+	assert.Equal(t, "12", ctx.Ticker.Ask.String())
+	assert.Equal(t, "11", ctx.Ticker.Bid.String())
+	assert.Equal(t, "2", ctx.Balance.Base.String())
+	assert.Equal(t, "8", ctx.BuyPrice.String())
+	assert.Equal(t, "37.5", ctx.Profit.String())
+	assert.Equal(t, map[string]interface{}{
+		"rsi1":   map[string]interface{}{"rsiVal": "28.5", "condsMet": true},
+		"level1": map[string]interface{}{"condsMet": false},
+	}, ctx.Tools)
+
+	// profit is not calculated in buy mode.
+	data.BuyPrice = decimal.Zero
+	assert.True(t, msgContext("ETH_BTC", "entry", data, bal, snap).Profit.IsZero())
+}
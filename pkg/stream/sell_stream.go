@@ -1,32 +1,64 @@
 package stream
 
 import (
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
+	"errors"
 )
 
+// ErrNothingToSell is returned by ForceSell when base
+// asset's value is below pair's min allowed value.
+var ErrNothingToSell = errors.New("base asset's value is too low to be sold")
+
 // Sell checks if base asset is ready to be sold, if it is,
 // it places a sell order with ticker's bid price to fill
 // the order as soon as possible.
 // If another sell order is open for this pair, it will
 // be cancelled.
 func (s *Stream) Sell(bal BalancesPair) error {
+	if err := s.sell(bal, false); err != ErrNothingToSell {
+		return err
+	}
+	return nil
+}
+
+// ForceSell places a sell order the same way as Sell, but
+// returns an error when the order cannot be placed because
+// of pair's exchange limits. ErrNothingToSell is returned when
+// there is no base asset to sell.
+func (s *Stream) ForceSell(bal BalancesPair) error {
+	return s.sell(bal, true)
+}
+
+// sell places a sell order, if strict is false, orders which
+// do not pass pair's exchange limits checks are silently skipped.
+func (s *Stream) sell(bal BalancesPair, strict bool) error {
 	// retrieve ticker data
 	ticker, err := s.Exchange.GetTicker(s.Pair)
 	if err != nil {
 		return s.prepError(err)
 	}
 
+	if mode(ticker.BidPrice, bal.Base, s.Pair.MinValue) == buyMode {
+		return ErrNothingToSell
+	}
+
 	// make final checks and apply final changes (number steps, precision rounding).
 	rate, amount, err := s.Pair.Transaction(ticker.BidPrice, bal.Base)
 	if err != nil {
+		if strict {
+			return s.prepError(err)
+		}
+
 		// no need to check for error here, if some
-		//conditions are not met, don't
+		// conditions are not met, don't
 		// place an order, that's all.
 		return nil
 	}
 
+	// rounded amount may be below min value.
 	if mode(rate, amount, s.Pair.MinValue) == buyMode {
-		return nil
+		return ErrNothingToSell
 	}
 
 	// if active sell order is present, cancel it
@@ -50,6 +82,7 @@ func (s *Stream) Sell(bal BalancesPair) error {
 			if err := s.Exchange.CancelOrder(s.Pair, ord.ID); err != nil {
 				return s.prepError(err)
 			}
+			s.orderEvent(event.OrderCancelled, ord, "")
 
 			// clear unconfirmed order cache.
 			s.cache.cancelUnconfirmed()
@@ -57,10 +90,11 @@ func (s *Stream) Sell(bal BalancesPair) error {
 	}
 
 	// place sell order
-	_, err = s.Exchange.Sell(s.Pair, rate, amount)
+	id, err := s.Exchange.Sell(s.Pair, rate, amount)
 	if err != nil {
 		return s.prepError(err)
 	}
+	s.orderEvent(event.OrderPlaced, exchange.Order{ID: id, Side: exchange.OrderSideSell, Rate: rate, Amount: amount}, "")
 
 	return nil
 }
@@ -0,0 +1,108 @@
+package stream
+
+import (
+	"bytes"
+	"encoding/json"
+	"eonbot/pkg/db"
+	"eonbot/pkg/strategy"
+
+	"github.com/sirupsen/logrus"
+)
+
+// restoreState retrieves previously saved strategy's state
+// from the db and applies it to the strategy's tools and outcomes.
+// Errors are only logged, because missing or outdated state
+// should not prevent stream from running.
+func (s *Stream) restoreState(str *strategy.Strategy) {
+	// retrieve saved state from the db.
+	d, err := s.DB.Persistent().GetStrategyState(s.Pair, str.Name())
+	if err != nil {
+		if err != db.ErrDataNotFound {
+			logrus.StandardLogger().WithField("action", "strategy state loading from db").Error(s.prepError(err))
+		}
+		return
+	}
+
+	var state strategy.State
+	if err := json.Unmarshal(d, &state); err != nil {
+		logrus.StandardLogger().WithField("action", "strategy state restoring").Error(s.prepError(err))
+		return
+	}
+
+	// apply state to the strategy.
+	if err := str.SetState(state); err != nil {
+		logrus.StandardLogger().WithField("action", "strategy state restoring").Error(s.prepError(err))
+	}
+
+	// remember strategy's state after restoring, so that it
+	// wouldn't be saved again if it doesn't change. Tools may
+	// normalize or reject parts of the saved state, in such
+	// case the cache is not seeded and the state is saved
+	// during the next cycle.
+	restored, err := str.State()
+	if err != nil {
+		return
+	}
+
+	rd, err := json.Marshal(restored)
+	if err != nil || !bytes.Equal(rd, d) {
+		return
+	}
+
+	s.cache.setState(str.Name(), rd)
+}
+
+// saveStates collects provided strategies states and saves
+// the changed ones to the db in a single transaction.
+func (s *Stream) saveStates(strats []*strategy.Strategy) {
+	states := make(map[string]json.RawMessage)
+	for _, str := range strats {
+		// collect strategy's state.
+		state, err := str.State()
+		if err != nil {
+			logrus.StandardLogger().WithField("action", "strategy state collecting").Error(s.prepError(err))
+			continue
+		}
+
+		d, err := json.Marshal(state)
+		if err != nil {
+			logrus.StandardLogger().WithField("action", "strategy state collecting").Error(s.prepError(err))
+			continue
+		}
+
+		// skip states that haven't changed
+		// since the last save.
+		if !s.cache.stateChanged(str.Name(), d) {
+			continue
+		}
+
+		states[str.Name()] = d
+	}
+
+	if len(states) == 0 {
+		return
+	}
+
+	// save states to the db.
+	if err := s.DB.Persistent().SaveStrategyStates(s.Pair, states); err != nil {
+		logrus.StandardLogger().WithField("action", "strategy state saving to db").Error(s.prepError(err))
+		return
+	}
+
+	for name, d := range states {
+		s.cache.setState(name, d)
+	}
+}
+
+// pruneStates removes saved states of strategies that
+// are not used by the stream anymore.
+func (s *Stream) pruneStates() {
+	keep := make([]string, 0, len(s.strategies))
+	for _, str := range s.strategies {
+		keep = append(keep, str.Name())
+	}
+
+	if err := s.DB.Persistent().PruneStrategyStates(s.Pair, keep); err != nil {
+		logrus.StandardLogger().WithField("action", "strategy states pruning").Error(s.prepError(err))
+	}
+}
@@ -3,8 +3,8 @@ package stream
 import (
 	"eonbot/pkg/asset"
 	"eonbot/pkg/db"
+	"eonbot/pkg/event"
 	"eonbot/pkg/exchange"
-	"eonbot/pkg/remote"
 	"eonbot/pkg/settings"
 	"eonbot/pkg/strategy"
 	"fmt"
@@ -24,10 +24,10 @@ type Stream struct {
 	// data.
 	Conf StreamConfig
 
-	// RC specifies remote controller
-	// object that should be used
-	// for notifications, etc.
-	RC remote.Manager
+	// Events specifies event bus
+	// that should be used for
+	// notifications, etc.
+	Events *event.Bus
 
 	// DB specifies database management
 	// object that should be used to store
@@ -64,11 +64,11 @@ type StreamConfig struct {
 }
 
 // New creates new asset pair stream.
-func New(pair asset.Pair, conf StreamConfig, rc remote.Manager, db db.Manager, exchange exchange.Exchange, strategies []strategy.Strategy) (*Stream, error) {
+func New(pair asset.Pair, conf StreamConfig, events *event.Bus, db db.Manager, exchange exchange.Exchange, strategies []strategy.Strategy) (*Stream, error) {
 	s := &Stream{
 		Pair:     pair,
 		Conf:     conf,
-		RC:       rc,
+		Events:   events,
 		DB:       db,
 		Exchange: exchange,
 		cache:    newCache(),
@@ -79,9 +79,18 @@ func New(pair asset.Pair, conf StreamConfig, rc remote.Manager, db db.Manager, e
 		if err != nil {
 			return nil, err
 		}
+
+		// restore strategy's state saved
+		// before restart / reload.
+		s.restoreState(strClone)
+
 		s.strategies = append(s.strategies, strClone)
 	}
 
+	// remove states of strategies which were
+	// removed from pair's config.
+	s.pruneStates()
+
 	return s, nil
 }
 
@@ -98,11 +107,24 @@ func (s *Stream) StrategiesInUse() map[string]int {
 }
 
 // UpdateStrategy updates strategy at a given index in the slice.
-func (s *Stream) UpdateStrategy(index int, strategy strategy.Strategy) {
-	if index < 0 || index > len(s.strategies) {
-		return
+// Saved state is restored to all tools and outcomes whose
+// configuration has not changed.
+func (s *Stream) UpdateStrategy(index int, str strategy.Strategy) error {
+	if index < 0 || index >= len(s.strategies) {
+		return nil
 	}
-	s.strategies[index] = &strategy
+
+	strClone, err := str.Clone()
+	if err != nil {
+		return err
+	}
+
+	// restore strategy's state saved
+	// before the update.
+	s.restoreState(strClone)
+
+	s.strategies[index] = strClone
+	return nil
 }
 
 // prepError decorates provided error with pair's code.
@@ -0,0 +1,116 @@
+package stream
+
+import (
+	"eonbot/pkg/db"
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/settings"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// recentOrders retrieves pair's filled orders that are needed to
+// check throttling limits from the db. Orders are sorted from the
+// oldest to the latest one.
+func (s *Stream) recentOrders(now time.Time) ([]exchange.BotOrder, error) {
+	thr := s.Conf.Config.Throttle
+	if thr == nil {
+		return nil, nil
+	}
+
+	period := time.Duration(thr.Cooldown) * time.Second
+	if d := time.Duration(thr.StrategyCooldown) * time.Second; d > period {
+		period = d
+	}
+
+	if thr.MaxDailyTrades > 0 && period < time.Hour*24 {
+		period = time.Hour * 24
+	}
+
+	// the last sell order is searched in the same period
+	// as the buy price is.
+	if thr.ReentryChange.IsPositive() {
+		if d := time.Hour * 24 * time.Duration(s.Conf.Config.OrderHistoryDayCount); d > period {
+			period = d
+		}
+	}
+
+	if period <= 0 {
+		return nil, nil
+	}
+
+	orders, err := s.DB.Persistent().GetPairOrders(s.Pair, now.Add(-period), now)
+	if err != nil {
+		if err == db.ErrDataNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return orders[s.Pair.String()], nil
+}
+
+// pairThrottled checks pair's throttling limits and returns the
+// reason why buy mode strategies are not allowed to act (empty if
+// they are allowed to).
+func pairThrottled(thr *settings.Throttle, orders []exchange.BotOrder, price decimal.Decimal, now time.Time) string {
+	if thr == nil || len(orders) == 0 {
+		return ""
+	}
+
+	latest := orders[len(orders)-1]
+	if cooldown := time.Duration(thr.Cooldown) * time.Second; cooldown > 0 {
+		if until := latest.Timestamp.Add(cooldown); now.Before(until) {
+			return fmt.Sprintf("pair cooldown is active until %s", until.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if thr.MaxDailyTrades > 0 {
+		var count int
+		for _, ord := range orders {
+			if ord.Side == exchange.OrderSideBuy && ord.Timestamp.After(now.Add(-time.Hour*24)) {
+				count++
+			}
+		}
+
+		if count >= thr.MaxDailyTrades {
+			return fmt.Sprintf("max daily trades (%d) limit is reached", thr.MaxDailyTrades)
+		}
+	}
+
+	if thr.ReentryChange.IsPositive() && price.IsPositive() {
+		for i := len(orders) - 1; i >= 0; i-- {
+			ord := orders[i]
+			if ord.Side != exchange.OrderSideSell {
+				continue
+			}
+
+			change := price.Sub(ord.Rate).Abs().Div(ord.Rate).Mul(decimal.New(100, 0))
+			if change.LessThan(thr.ReentryChange) {
+				return fmt.Sprintf("price has moved only %s%% from the last exit rate %s", change.StringFixed(2), ord.Rate.String())
+			}
+			break
+		}
+	}
+
+	return ""
+}
+
+// strategyThrottled checks whether the strategy's
+// cooldown is still active.
+func strategyThrottled(thr *settings.Throttle, orders []exchange.BotOrder, strat string, now time.Time) bool {
+	if thr == nil || thr.StrategyCooldown <= 0 {
+		return false
+	}
+
+	cooldown := time.Duration(thr.StrategyCooldown) * time.Second
+	for i := len(orders) - 1; i >= 0; i-- {
+		if orders[i].Strategy != strat {
+			continue
+		}
+		return now.Before(orders[i].Timestamp.Add(cooldown))
+	}
+
+	return false
+}
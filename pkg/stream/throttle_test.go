@@ -0,0 +1,120 @@
+package stream
+
+import (
+	"eonbot/pkg/exchange"
+	"eonbot/pkg/settings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func botOrder(ago time.Duration, side string, rate int64, strat string) exchange.BotOrder {
+	return exchange.NewBotOrder(exchange.Order{
+		Timestamp: now.Add(-ago),
+		IsFilled:  true,
+		Amount:    decimal.New(1, 0),
+		Rate:      decimal.New(rate, 0),
+		Side:      side,
+	}, strat)
+}
+
+func TestPairThrottled(t *testing.T) {
+	orders := []exchange.BotOrder{
+		botOrder(time.Hour*30, exchange.OrderSideBuy, 100, "entry"),
+		botOrder(time.Hour*20, exchange.OrderSideBuy, 90, "entry"),
+		botOrder(time.Hour*10, exchange.OrderSideBuy, 95, "entry"),
+		botOrder(time.Minute*5, exchange.OrderSideSell, 100, "exit"),
+	}
+
+	tests := []struct {
+		Name      string
+		Throttle  *settings.Throttle
+		Orders    []exchange.BotOrder
+		Price     int64
+		Throttled bool
+	}{
+		{
+			Name:      "Not throttled when settings are not specified",
+			Throttle:  nil,
+			Orders:    orders,
+			Price:     100,
+			Throttled: false,
+		},
+		{
+			Name:      "Not throttled when there are no orders",
+			Throttle:  &settings.Throttle{Cooldown: 600},
+			Price:     100,
+			Throttled: false,
+		},
+		{
+			Name:      "Throttled when pair cooldown is active",
+			Throttle:  &settings.Throttle{Cooldown: 600},
+			Orders:    orders,
+			Price:     100,
+			Throttled: true,
+		},
+		{
+			Name:      "Not throttled when pair cooldown has passed",
+			Throttle:  &settings.Throttle{Cooldown: 60},
+			Orders:    orders,
+			Price:     100,
+			Throttled: false,
+		},
+		{
+			Name:      "Throttled when max daily trades limit is reached",
+			Throttle:  &settings.Throttle{MaxDailyTrades: 2},
+			Orders:    orders,
+			Price:     100,
+			Throttled: true,
+		},
+		{
+			Name:      "Not throttled when max daily trades limit is not reached",
+			Throttle:  &settings.Throttle{MaxDailyTrades: 3},
+			Orders:    orders,
+			Price:     100,
+			Throttled: false,
+		},
+		{
+			Name:      "Throttled when price has not moved enough from the last exit",
+			Throttle:  &settings.Throttle{ReentryChange: decimal.New(5, 0)},
+			Orders:    orders,
+			Price:     97,
+			Throttled: true,
+		},
+		{
+			Name:      "Not throttled when price has moved enough from the last exit",
+			Throttle:  &settings.Throttle{ReentryChange: decimal.New(5, 0)},
+			Orders:    orders,
+			Price:     94,
+			Throttled: false,
+		},
+	}
+
+	for _, v := range tests {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			t.Parallel()
+			reason := pairThrottled(v.Throttle, v.Orders, decimal.New(v.Price, 0), now)
+			assert.Equal(t, v.Throttled, reason != "")
+		})
+	}
+}
+
+func TestStrategyThrottled(t *testing.T) {
+	orders := []exchange.BotOrder{
+		botOrder(time.Minute*30, exchange.OrderSideBuy, 100, "entry"),
+		botOrder(time.Minute*5, exchange.OrderSideSell, 100, "exit"),
+	}
+
+	thr := &settings.Throttle{StrategyCooldown: 900}
+
+	assert.False(t, strategyThrottled(nil, orders, "exit", now))
+	assert.False(t, strategyThrottled(&settings.Throttle{}, orders, "exit", now))
+	assert.True(t, strategyThrottled(thr, orders, "exit", now))
+	assert.False(t, strategyThrottled(thr, orders, "entry", now))
+	assert.False(t, strategyThrottled(thr, orders, "other", now))
+}